@@ -0,0 +1,145 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+func dataSourceNsxtComputeCollection() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtComputeCollectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "External ID of this compute collection",
+				Optional:    true,
+				Computed:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+				Computed:    true,
+			},
+			"origin_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the compute manager this compute collection was discovered from",
+				Optional:    true,
+				Computed:    true,
+			},
+			"origin_type": {
+				Type:        schema.TypeString,
+				Description: "Compute collection type, such as VC_Cluster",
+				Optional:    true,
+				Computed:    true,
+			},
+			"cm_local_id": {
+				Type:        schema.TypeString,
+				Description: "Local ID of the compute collection in the compute manager",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtComputeCollectionRead(d *schema.ResourceData, m interface{}) error {
+	// Read a compute collection by name or id
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return dataSourceNotSupportedError()
+	}
+
+	objID := d.Get("id").(string)
+	objName := d.Get("display_name").(string)
+	originID := d.Get("origin_id").(string)
+	originType := d.Get("origin_type").(string)
+	var obj manager.ComputeCollection
+	if objID != "" {
+		// Get by id
+		objGet, resp, err := nsxClient.FabricApi.ReadComputeCollection(nsxClient.Context, objID)
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Compute collection %s was not found", objID)
+		}
+		if err != nil {
+			return fmt.Errorf("Error while reading compute collection %s: %v", objID, err)
+		}
+		obj = objGet
+
+	} else if objName == "" {
+		return fmt.Errorf("Error obtaining compute collection ID or name during read")
+	} else {
+		// Get by full name/prefix
+		var perfectMatch []manager.ComputeCollection
+		var prefixMatch []manager.ComputeCollection
+		lister := func(info *paginationInfo) error {
+			if originID != "" {
+				info.LocalVarOptionals["originId"] = originID
+			}
+			if originType != "" {
+				info.LocalVarOptionals["originType"] = originType
+			}
+			objList, _, err := nsxClient.FabricApi.ListComputeCollections(nsxClient.Context, info.LocalVarOptionals)
+			if err != nil {
+				return fmt.Errorf("Error while reading compute collections: %v", err)
+			}
+
+			info.PageCount = int64(len(objList.Results))
+			info.TotalCount = objList.ResultCount
+			info.Cursor = objList.Cursor
+
+			// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
+			for _, objInList := range objList.Results {
+				if strings.HasPrefix(objInList.DisplayName, objName) {
+					prefixMatch = append(prefixMatch, objInList)
+				}
+				if objInList.DisplayName == objName {
+					perfectMatch = append(perfectMatch, objInList)
+				}
+			}
+			return nil
+		}
+
+		_, err := handlePagination(lister)
+		if err != nil {
+			return err
+		}
+
+		if len(perfectMatch) > 0 {
+			if len(perfectMatch) > 1 {
+				return fmt.Errorf("Found multiple compute collections with name '%s'", objName)
+			}
+			obj = perfectMatch[0]
+		} else if len(prefixMatch) > 0 {
+			if len(prefixMatch) > 1 {
+				return fmt.Errorf("Found multiple compute collections with name starting with '%s'", objName)
+			}
+			obj = prefixMatch[0]
+		} else {
+			return fmt.Errorf("Compute collection with name '%s' was not found", objName)
+		}
+	}
+
+	d.SetId(obj.ExternalId)
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	d.Set("origin_id", obj.OriginId)
+	d.Set("origin_type", obj.OriginType)
+	d.Set("cm_local_id", obj.CmLocalId)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtComputeCollection_basic(t *testing.T) {
+	computeCollectionName := getTestComputeCollectionName()
+	testResourceName := "data.nsxt_compute_collection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccTestMP(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_COMPUTE_COLLECTION")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXComputeCollectionReadTemplate(computeCollectionName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", computeCollectionName),
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "origin_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXComputeCollectionReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_compute_collection" "test" {
+  display_name = "%s"
+}`, name)
+}
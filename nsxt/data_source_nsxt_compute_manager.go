@@ -0,0 +1,129 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+func dataSourceNsxtComputeManager() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtComputeManagerRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "Unique ID of this resource",
+				Optional:    true,
+				Computed:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+				Computed:    true,
+			},
+			"server": {
+				Type:        schema.TypeString,
+				Description: "IP address or hostname of compute manager",
+				Computed:    true,
+			},
+			"origin_type": {
+				Type:        schema.TypeString,
+				Description: "Compute manager type, such as vCenter",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtComputeManagerRead(d *schema.ResourceData, m interface{}) error {
+	// Read a compute manager by name or id
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return dataSourceNotSupportedError()
+	}
+
+	objID := d.Get("id").(string)
+	objName := d.Get("display_name").(string)
+	var obj manager.ComputeManager
+	if objID != "" {
+		// Get by id
+		objGet, resp, err := nsxClient.FabricApi.ReadComputeManager(nsxClient.Context, objID)
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Compute manager %s was not found", objID)
+		}
+		if err != nil {
+			return fmt.Errorf("Error while reading compute manager %s: %v", objID, err)
+		}
+		obj = objGet
+
+	} else if objName == "" {
+		return fmt.Errorf("Error obtaining compute manager ID or name during read")
+	} else {
+		// Get by full name/prefix
+		var perfectMatch []manager.ComputeManager
+		var prefixMatch []manager.ComputeManager
+		lister := func(info *paginationInfo) error {
+			objList, _, err := nsxClient.FabricApi.ListComputeManagers(nsxClient.Context, info.LocalVarOptionals)
+			if err != nil {
+				return fmt.Errorf("Error while reading compute managers: %v", err)
+			}
+
+			info.PageCount = int64(len(objList.Results))
+			info.TotalCount = objList.ResultCount
+			info.Cursor = objList.Cursor
+
+			// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
+			for _, objInList := range objList.Results {
+				if strings.HasPrefix(objInList.DisplayName, objName) {
+					prefixMatch = append(prefixMatch, objInList)
+				}
+				if objInList.DisplayName == objName {
+					perfectMatch = append(perfectMatch, objInList)
+				}
+			}
+			return nil
+		}
+
+		_, err := handlePagination(lister)
+		if err != nil {
+			return err
+		}
+
+		if len(perfectMatch) > 0 {
+			if len(perfectMatch) > 1 {
+				return fmt.Errorf("Found multiple compute managers with name '%s'", objName)
+			}
+			obj = perfectMatch[0]
+		} else if len(prefixMatch) > 0 {
+			if len(prefixMatch) > 1 {
+				return fmt.Errorf("Found multiple compute managers with name starting with '%s'", objName)
+			}
+			obj = prefixMatch[0]
+		} else {
+			return fmt.Errorf("Compute manager with name '%s' was not found", objName)
+		}
+	}
+
+	d.SetId(obj.Id)
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	d.Set("server", obj.Server)
+	d.Set("origin_type", obj.OriginType)
+
+	return nil
+}
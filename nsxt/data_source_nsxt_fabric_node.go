@@ -0,0 +1,160 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+var fabricNodeResourceTypeValues = []string{"HostNode", "EdgeNode", "PublicCloudGatewayNode"}
+
+func dataSourceNsxtFabricNode() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtFabricNodeRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "Unique ID of this resource",
+				Optional:    true,
+				Computed:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Computed:    true,
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Description:  "Management IP address of the fabric node",
+				Optional:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Description:  "Fabric node type",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(fabricNodeResourceTypeValues, false),
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Description: "IP addresses of the fabric node",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Description: "Fully qualified domain name of the fabric node",
+				Computed:    true,
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the node maintained on the node itself",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtFabricNodeRead(d *schema.ResourceData, m interface{}) error {
+	// Read a fabric node by id, name or management IP
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return dataSourceNotSupportedError()
+	}
+
+	objID := d.Get("id").(string)
+	objName := d.Get("display_name").(string)
+	ipAddress := d.Get("ip_address").(string)
+	resourceType := d.Get("resource_type").(string)
+	var obj manager.Node
+	if objID != "" {
+		// Get by id
+		objGet, resp, err := nsxClient.FabricApi.ReadNode(nsxClient.Context, objID)
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Fabric node %s was not found", objID)
+		}
+		if err != nil {
+			return fmt.Errorf("Error while reading fabric node %s: %v", objID, err)
+		}
+		obj = objGet
+
+	} else if objName == "" && ipAddress == "" {
+		return fmt.Errorf("Error obtaining fabric node ID, name or IP address during read")
+	} else {
+		var matches []manager.Node
+		lister := func(info *paginationInfo) error {
+			// Filter on server side where possible
+			if objName != "" {
+				info.LocalVarOptionals["displayName"] = objName
+			}
+			if ipAddress != "" {
+				info.LocalVarOptionals["ipAddress"] = ipAddress
+			}
+			if resourceType != "" {
+				info.LocalVarOptionals["resourceType"] = resourceType
+			}
+			objList, _, err := nsxClient.FabricApi.ListNodes(nsxClient.Context, info.LocalVarOptionals)
+			if err != nil {
+				return fmt.Errorf("Error while reading fabric nodes: %v", err)
+			}
+
+			info.PageCount = int64(len(objList.Results))
+			info.TotalCount = objList.ResultCount
+			info.Cursor = objList.Cursor
+
+			for _, objInList := range objList.Results {
+				if objName != "" && objInList.DisplayName != objName {
+					continue
+				}
+				if ipAddress != "" && !stringInList(ipAddress, objInList.IpAddresses) && !stringInList(ipAddress, objInList.DiscoveredIpAddresses) {
+					continue
+				}
+				if resourceType != "" && objInList.ResourceType != resourceType {
+					continue
+				}
+				matches = append(matches, objInList)
+			}
+			return nil
+		}
+
+		_, err := handlePagination(lister)
+		if err != nil {
+			return err
+		}
+
+		if len(matches) == 0 {
+			return fmt.Errorf("Fabric node with name '%s' and IP address '%s' was not found", objName, ipAddress)
+		}
+		if len(matches) > 1 {
+			return fmt.Errorf("Found %d fabric nodes with name '%s' and IP address '%s'", len(matches), objName, ipAddress)
+		}
+		obj = matches[0]
+	}
+
+	d.SetId(obj.Id)
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	d.Set("resource_type", obj.ResourceType)
+	d.Set("ip_addresses", obj.IpAddresses)
+	d.Set("fqdn", obj.Fqdn)
+	d.Set("external_id", obj.ExternalId)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtFabricNode_basic(t *testing.T) {
+	nodeID := getTestFabricNodeID()
+	testResourceName := "data.nsxt_fabric_node.test"
+	byNameResourceName := "data.nsxt_fabric_node.by_name"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccTestMP(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_FABRIC_NODE_ID")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXFabricNodeReadTemplate(nodeID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "id", nodeID),
+					resource.TestCheckResourceAttrSet(testResourceName, "display_name"),
+					resource.TestCheckResourceAttrSet(testResourceName, "resource_type"),
+					resource.TestCheckResourceAttrPair(byNameResourceName, "id", testResourceName, "id"),
+					resource.TestCheckResourceAttrPair(byNameResourceName, "resource_type", testResourceName, "resource_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXFabricNodeReadTemplate(id string) string {
+	return fmt.Sprintf(`
+data "nsxt_fabric_node" "test" {
+  id = "%s"
+}
+
+data "nsxt_fabric_node" "by_name" {
+  display_name = data.nsxt_fabric_node.test.display_name
+}`, id)
+}
+
+func TestDataSourceNsxtFabricNodeRead(t *testing.T) {
+	server := newTestFabricNodeServer()
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+	m := nsxtClients{NsxtClient: client}
+
+	cases := []struct {
+		config     map[string]interface{}
+		expectedID string
+		errMessage string
+	}{
+		{map[string]interface{}{"display_name": "edge1"}, "node1", ""},
+		{map[string]interface{}{"ip_address": "10.0.0.3"}, "node3", ""},
+		{map[string]interface{}{"display_name": "host1", "ip_address": "10.0.0.2"}, "node2", ""},
+		{map[string]interface{}{"display_name": "host1"}, "", "Found 2 fabric nodes"},
+		{map[string]interface{}{"display_name": "edge2"}, "", "was not found"},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, dataSourceNsxtFabricNode().Schema, tc.config)
+		err := dataSourceNsxtFabricNodeRead(d, m)
+		if tc.errMessage != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.errMessage) {
+				t.Errorf("Expected error %q for %v, got %v", tc.errMessage, tc.config, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for %v: %v", tc.config, err)
+			continue
+		}
+		if d.Id() != tc.expectedID {
+			t.Errorf("Expected fabric node %s for %v, got %s", tc.expectedID, tc.config, d.Id())
+		}
+	}
+}
@@ -0,0 +1,99 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const licenseExpiryWarningPeriod = 30 * 24 * time.Hour
+
+func dataSourceNsxtLicenseCompliance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtLicenseComplianceRead,
+
+		Schema: map[string]*schema.Schema{
+			"has_expired_license": {
+				Type:        schema.TypeBool,
+				Description: "Whether any of configured licenses has expired",
+				Computed:    true,
+			},
+			"has_eval_license": {
+				Type:        schema.TypeBool,
+				Description: "Whether any of configured licenses is an evaluation license",
+				Computed:    true,
+			},
+			"expiring_within_30_days": {
+				Type:        schema.TypeList,
+				Description: "Valid licenses that expire within 30 days",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_name": {
+							Type:        schema.TypeString,
+							Description: "Product name",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "License edition",
+							Computed:    true,
+						},
+						"expiry": {
+							Type:        schema.TypeInt,
+							Description: "License expiry date, in epoch milliseconds",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtLicenseComplianceRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return dataSourceNotSupportedError()
+	}
+
+	licenses, err := listLicensesWithCapacityTypes(nsxClient)
+	if err != nil {
+		return err
+	}
+
+	hasExpired := false
+	hasEval := false
+	// Expiry is reported in epoch milliseconds, zero for licenses that never expire
+	now := time.Now()
+	deadline := now.Add(licenseExpiryWarningPeriod).UnixNano() / int64(time.Millisecond)
+	expiring := make([]map[string]interface{}, 0)
+	for _, license := range licenses {
+		if license.IsExpired {
+			hasExpired = true
+		}
+		if license.IsEval {
+			hasEval = true
+		}
+		if !license.IsExpired && license.Expiry > 0 && license.Expiry <= deadline {
+			elem := make(map[string]interface{})
+			elem["product_name"] = license.ProductName
+			elem["description"] = license.Description
+			elem["expiry"] = license.Expiry
+			expiring = append(expiring, elem)
+		}
+	}
+
+	d.Set("has_expired_license", hasExpired)
+	d.Set("has_eval_license", hasEval)
+	err = d.Set("expiring_within_30_days", expiring)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("license_compliance")
+	return nil
+}
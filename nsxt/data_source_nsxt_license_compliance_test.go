@@ -0,0 +1,88 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtLicenseCompliance_basic(t *testing.T) {
+	testResourceName := "data.nsxt_license_compliance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "nsxt_license_compliance" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "has_expired_license"),
+					resource.TestCheckResourceAttrSet(testResourceName, "has_eval_license"),
+					resource.TestCheckResourceAttrSet(testResourceName, "expiring_within_30_days.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceNsxtLicenseComplianceRead(t *testing.T) {
+	nowMs := time.Now().UnixNano() / int64(time.Millisecond)
+	dayMs := int64(24 * time.Hour / time.Millisecond)
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/batch" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"has_errors": false, "results": [{"code": 200, "body": %s}]}`, body)
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+	m := nsxtClients{NsxtClient: client}
+
+	body = fmt.Sprintf(`{"result_count": 4, "results": [
+	  {"license_key": "key1", "description": "Enterprise", "capacity_type": "CPU"},
+	  {"license_key": "key2", "description": "Advanced", "capacity_type": "CPU", "expiry": %d},
+	  {"license_key": "key3", "description": "Evaluation", "capacity_type": "VM", "is_eval": true, "is_expired": true, "expiry": %d},
+	  {"license_key": "key4", "description": "Standard", "capacity_type": "VM", "expiry": %d}]}`, nowMs+10*dayMs, nowMs-dayMs, nowMs+60*dayMs)
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtLicenseCompliance().Schema, map[string]interface{}{})
+	err := dataSourceNsxtLicenseComplianceRead(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.Get("has_expired_license").(bool) || !d.Get("has_eval_license").(bool) {
+		t.Errorf("Expected expired and eval licenses to be detected")
+	}
+	expiring := d.Get("expiring_within_30_days").([]interface{})
+	if len(expiring) != 1 {
+		t.Fatalf("Expected only Advanced license to be expiring, got %v", expiring)
+	}
+	elem := expiring[0].(map[string]interface{})
+	if elem["description"].(string) != "Advanced" || elem["expiry"].(int) != int(nowMs+10*dayMs) {
+		t.Errorf("Expected Advanced license expiring at %d, got %v", nowMs+10*dayMs, elem)
+	}
+	if d.Id() != "license_compliance" {
+		t.Errorf("Unexpected data source ID %s", d.Id())
+	}
+
+	body = `{"result_count": 1, "results": [{"license_key": "key1", "description": "Enterprise", "capacity_type": "CPU"}]}`
+	d = schema.TestResourceDataRaw(t, dataSourceNsxtLicenseCompliance().Schema, map[string]interface{}{})
+	err = dataSourceNsxtLicenseComplianceRead(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("has_expired_license").(bool) || d.Get("has_eval_license").(bool) || d.Get("expiring_within_30_days.#").(int) != 0 {
+		t.Errorf("Expected compliant licenses, got expired %v, eval %v, expiring %v", d.Get("has_expired_license"), d.Get("has_eval_license"), d.Get("expiring_within_30_days"))
+	}
+}
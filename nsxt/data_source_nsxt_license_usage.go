@@ -0,0 +1,200 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/licensing"
+)
+
+// License covering multiple capacity types reports capacity_type as a list,
+// while MP SDK models it as a single string
+type licenseCapacityTypes []string
+
+func (c *licenseCapacityTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*c = nil
+		if single != "" {
+			*c = []string{single}
+		}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("Unexpected license capacity type %s: %v", data, err)
+	}
+	*c = list
+	return nil
+}
+
+type licenseWithCapacityTypes struct {
+	licensing.License
+	// Shadows capacity type of the embedded license
+	CapacityType licenseCapacityTypes `json:"capacity_type,omitempty"`
+}
+
+type licenseWithCapacityTypesList struct {
+	Cursor      string                     `json:"cursor,omitempty"`
+	ResultCount int64                      `json:"result_count,omitempty"`
+	Results     []licenseWithCapacityTypes `json:"results"`
+}
+
+// Licenses are retrieved via batch API, since MP SDK fails to parse
+// licenses with multiple capacity types
+func listLicensesWithCapacityTypes(nsxClient *api.APIClient) ([]licenseWithCapacityTypes, error) {
+	var licenses []licenseWithCapacityTypes
+	lister := func(info *paginationInfo) error {
+		uri := "/v1/licenses"
+		if info.Cursor != "" {
+			uri = fmt.Sprintf("%s?cursor=%s", uri, url.QueryEscape(info.Cursor))
+		}
+
+		var licenseList licenseWithCapacityTypesList
+		err := callMPAPIWithBatch(nsxClient, http.MethodGet, uri, nil, &licenseList)
+		if err != nil {
+			return fmt.Errorf("Error while reading licenses: %v", err)
+		}
+
+		info.PageCount = int64(len(licenseList.Results))
+		info.TotalCount = licenseList.ResultCount
+		info.Cursor = licenseList.Cursor
+		licenses = append(licenses, licenseList.Results...)
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	return licenses, err
+}
+
+func dataSourceNsxtLicenseUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtLicenseUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"capacity_types": {
+				Type:        schema.TypeSet,
+				Description: "Capacity types covered by configured licenses",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"usage": {
+				Type:        schema.TypeList,
+				Description: "Licensed and used capacity per capacity type",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"capacity_type": {
+							Type:        schema.TypeString,
+							Description: "Capacity type, such as CPU or VM",
+							Computed:    true,
+						},
+						"total": {
+							Type:        schema.TypeInt,
+							Description: "Total capacity of configured licenses",
+							Computed:    true,
+						},
+						"used": {
+							Type:        schema.TypeInt,
+							Description: "Consumed capacity",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtLicenseUsageRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return dataSourceNotSupportedError()
+	}
+	if nsxClient.LicensingApi == nil {
+		return fmt.Errorf("%v: LicensingApi is not available in NSX client", dataSourceNotSupportedError())
+	}
+
+	licenses, err := listLicensesWithCapacityTypes(nsxClient)
+	if err != nil {
+		return err
+	}
+
+	report, resp, err := nsxClient.LicensingApi.GetLicenseUsageReport(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error while reading license usage report: %v", err)
+	}
+	if resp != nil && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Unexpected Response while reading license usage report. Status Code: %d", resp.StatusCode)
+	}
+
+	// Quantity of license covering multiple capacity types applies to each
+	totals := make(map[string]int64)
+	var licensedTypes []string
+	for _, license := range licenses {
+		if license.IsExpired {
+			continue
+		}
+		for _, capacityType := range license.CapacityType {
+			if _, ok := totals[capacityType]; !ok {
+				licensedTypes = append(licensedTypes, capacityType)
+			}
+			totals[capacityType] += license.Quantity
+		}
+	}
+
+	// Usage is reported per feature, and features licensed by the same
+	// capacity type consume the same units (such as the same host CPUs).
+	// Summing them would count those units multiple times, hence the
+	// highest usage reported for capacity type is used.
+	used := make(map[string]int64)
+	for _, feature := range report.FeatureUsageInfo {
+		for _, capacity := range feature.CapacityUsage {
+			if capacity.UsageCount > used[capacity.CapacityType] {
+				used[capacity.CapacityType] = capacity.UsageCount
+			}
+			if _, ok := totals[capacity.CapacityType]; !ok {
+				totals[capacity.CapacityType] = 0
+			}
+		}
+	}
+
+	var capacityTypes []string
+	for capacityType := range totals {
+		capacityTypes = append(capacityTypes, capacityType)
+	}
+	sort.Strings(capacityTypes)
+
+	var usageList []map[string]interface{}
+	for _, capacityType := range capacityTypes {
+		elem := make(map[string]interface{})
+		elem["capacity_type"] = capacityType
+		elem["total"] = totals[capacityType]
+		elem["used"] = used[capacityType]
+		usageList = append(usageList, elem)
+	}
+
+	err = d.Set("usage", usageList)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("capacity_types", licensedTypes)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("license_usage")
+	return nil
+}
@@ -0,0 +1,91 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+)
+
+func TestAccDataSourceNsxtLicenseUsage_basic(t *testing.T) {
+	testResourceName := "data.nsxt_license_usage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "nsxt_license_usage" "test" {
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "usage.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceNsxtLicenseUsage_noLicensingApi(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtLicenseUsage().Schema, map[string]interface{}{})
+	clients := nsxtClients{NsxtClient: &api.APIClient{}}
+
+	err := dataSourceNsxtLicenseUsageRead(d, clients)
+	if err == nil || !strings.Contains(err.Error(), "LicensingApi") {
+		t.Fatalf("expected error naming LicensingApi, got: %v", err)
+	}
+}
+
+func TestDataSourceNsxtLicenseUsage_multipleCapacityTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/batch":
+			fmt.Fprint(w, `{"has_errors": false, "results": [{"code": 200, "body": {"result_count": 3, "results": [
+			  {"license_key": "key1", "capacity_type": ["CPU", "VM"], "quantity": 8},
+			  {"license_key": "key2", "capacity_type": "CPU", "quantity": 4},
+			  {"license_key": "key3", "capacity_type": "USER", "quantity": 10, "is_expired": true}]}}]}`)
+		case "/api/v1/licenses/licenses-usage":
+			fmt.Fprint(w, `{"feature_usage_info": [{"feature": "firewall", "capacity_usage": [
+			  {"capacity_type": "CPU", "usage_count": 6}, {"capacity_type": "VM", "usage_count": 3}]}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtLicenseUsage().Schema, map[string]interface{}{})
+	err := dataSourceNsxtLicenseUsageRead(d, nsxtClients{NsxtClient: client})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	capacityTypes := d.Get("capacity_types").(*schema.Set)
+	if capacityTypes.Len() != 2 || !capacityTypes.Contains("CPU") || !capacityTypes.Contains("VM") {
+		t.Errorf("Expected capacity types CPU and VM, got %v", capacityTypes.List())
+	}
+
+	expected := map[string][]int{"CPU": {12, 6}, "VM": {8, 3}}
+	usage := d.Get("usage").([]interface{})
+	if len(usage) != len(expected) {
+		t.Fatalf("Expected usage for %d capacity types, got %v", len(expected), usage)
+	}
+	for _, item := range usage {
+		elem := item.(map[string]interface{})
+		values := expected[elem["capacity_type"].(string)]
+		if values == nil || elem["total"].(int) != values[0] || elem["used"].(int) != values[1] {
+			t.Errorf("Unexpected usage %v", elem)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtLicenses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtLicensesRead,
+
+		Schema: map[string]*schema.Schema{
+			"is_eval": {
+				Type:        schema.TypeBool,
+				Description: "If set, only evaluation (true) or only non-evaluation (false) licenses are returned",
+				Optional:    true,
+			},
+			"is_expired": {
+				Type:        schema.TypeBool,
+				Description: "If set, only expired (true) or only valid (false) licenses are returned",
+				Optional:    true,
+			},
+			"expired_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of expired licenses configured on NSX",
+				Computed:    true,
+			},
+			"eval_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of evaluation licenses configured on NSX",
+				Computed:    true,
+			},
+			"license": {
+				Type:        schema.TypeList,
+				Description: "Licenses matching the filters",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_name": {
+							Type:        schema.TypeString,
+							Description: "Product name",
+							Computed:    true,
+						},
+						"product_version": {
+							Type:        schema.TypeString,
+							Description: "Product version",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "License edition",
+							Computed:    true,
+						},
+						"features": {
+							Type:        schema.TypeString,
+							Description: "Features included in the license",
+							Computed:    true,
+						},
+						"capacity_types": {
+							Type:        schema.TypeList,
+							Description: "Capacity types covered by the license",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"quantity": {
+							Type:        schema.TypeInt,
+							Description: "License capacity",
+							Computed:    true,
+						},
+						"expiry": {
+							Type:        schema.TypeInt,
+							Description: "License expiry date, in epoch milliseconds",
+							Computed:    true,
+						},
+						"is_eval": {
+							Type:        schema.TypeBool,
+							Description: "Whether the license is an evaluation license",
+							Computed:    true,
+						},
+						"is_expired": {
+							Type:        schema.TypeBool,
+							Description: "Whether the license has expired",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtLicensesRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return dataSourceNotSupportedError()
+	}
+
+	licenses, err := listLicensesWithCapacityTypes(nsxClient)
+	if err != nil {
+		return err
+	}
+
+	// Licenses API does not support filtering, hence filters are applied
+	// on the retrieved list. Counts refer to all configured licenses.
+	isEval, filterEval := d.GetOkExists("is_eval")
+	isExpired, filterExpired := d.GetOkExists("is_expired")
+	expiredCount := 0
+	evalCount := 0
+	var licenseList []map[string]interface{}
+	for _, license := range licenses {
+		if license.IsExpired {
+			expiredCount++
+		}
+		if license.IsEval {
+			evalCount++
+		}
+		if filterEval && license.IsEval != isEval.(bool) {
+			continue
+		}
+		if filterExpired && license.IsExpired != isExpired.(bool) {
+			continue
+		}
+
+		// License key is intentionally not exposed
+		elem := make(map[string]interface{})
+		elem["product_name"] = license.ProductName
+		elem["product_version"] = license.ProductVersion
+		elem["description"] = license.Description
+		elem["features"] = license.Features
+		elem["capacity_types"] = []string(license.CapacityType)
+		elem["quantity"] = license.Quantity
+		elem["expiry"] = license.Expiry
+		elem["is_eval"] = license.IsEval
+		elem["is_expired"] = license.IsExpired
+		licenseList = append(licenseList, elem)
+	}
+
+	err = d.Set("license", licenseList)
+	if err != nil {
+		return err
+	}
+	d.Set("expired_count", expiredCount)
+	d.Set("eval_count", evalCount)
+
+	d.SetId("licenses")
+	return nil
+}
@@ -0,0 +1,108 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/apiservice"
+)
+
+func TestAccDataSourceNsxtLicenses_basic(t *testing.T) {
+	testResourceName := "data.nsxt_licenses.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "nsxt_licenses" "test" {
+  is_expired = false
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "license.#"),
+					resource.TestCheckResourceAttrSet(testResourceName, "expired_count"),
+					resource.TestCheckResourceAttrSet(testResourceName, "eval_count"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceNsxtLicensesRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/batch" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var batch apiservice.BatchRequest
+		err := json.NewDecoder(r.Body).Decode(&batch)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// Licenses are returned in two pages
+		var body string
+		switch batch.Requests[0].Uri {
+		case "/v1/licenses":
+			body = `{"result_count": 3, "cursor": "page2", "results": [
+			  {"license_key": "key1", "description": "Enterprise", "capacity_type": ["CPU", "VM"], "quantity": 8},
+			  {"license_key": "key2", "description": "Evaluation", "capacity_type": "CPU", "is_eval": true, "is_expired": true}]}`
+		case "/v1/licenses?cursor=page2":
+			body = `{"result_count": 3, "results": [
+			  {"license_key": "key3", "description": "Advanced", "capacity_type": "USER", "quantity": 10, "is_expired": true}]}`
+		default:
+			fmt.Fprint(w, `{"has_errors": true, "results": [{"code": 404}]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"has_errors": false, "results": [{"code": 200, "body": %s}]}`, body)
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+	m := nsxtClients{NsxtClient: client}
+
+	cases := []struct {
+		config       map[string]interface{}
+		descriptions []string
+	}{
+		{map[string]interface{}{}, []string{"Enterprise", "Evaluation", "Advanced"}},
+		{map[string]interface{}{"is_expired": false}, []string{"Enterprise"}},
+		{map[string]interface{}{"is_expired": true, "is_eval": false}, []string{"Advanced"}},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, dataSourceNsxtLicenses().Schema, tc.config)
+		err := dataSourceNsxtLicensesRead(d, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if d.Get("expired_count").(int) != 2 || d.Get("eval_count").(int) != 1 {
+			t.Errorf("Unexpected counts for %v: expired %v, eval %v", tc.config, d.Get("expired_count"), d.Get("eval_count"))
+		}
+
+		licenses := d.Get("license").([]interface{})
+		if len(licenses) != len(tc.descriptions) {
+			t.Errorf("Expected %d licenses for %v, got %v", len(tc.descriptions), tc.config, licenses)
+			continue
+		}
+		for i, item := range licenses {
+			if description := item.(map[string]interface{})["description"].(string); description != tc.descriptions[i] {
+				t.Errorf("Expected license %s for %v, got %s", tc.descriptions[i], tc.config, description)
+			}
+		}
+	}
+}
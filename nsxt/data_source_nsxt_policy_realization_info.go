@@ -5,6 +5,7 @@ package nsxt
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -44,6 +45,25 @@ func dataSourceNsxtPolicyRealizationInfo() *schema.Resource {
 				Description: "The ID of the realized resource",
 				Computed:    true,
 			},
+			"alarms": {
+				Type:        schema.TypeList,
+				Description: "Alarms raised for the realized resource",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message": {
+							Type:        schema.TypeString,
+							Description: "Alarm message",
+							Computed:    true,
+						},
+						"error_details": {
+							Type:        schema.TypeString,
+							Description: "Details of the error that caused the alarm",
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"site_path": {
 				Type:         schema.TypeString,
 				Description:  "Path of the site this resource belongs to",
@@ -127,6 +147,7 @@ func dataSourceNsxtPolicyRealizationInfoRead(d *schema.ResourceData, m interface
 					if entityType == "" {
 						// Take the first one
 						d.Set("state", state)
+						setPolicyRealizationAlarmsInSchema(d, objInList.Alarms)
 						if objInList.EntityType != nil {
 							d.Set("entity_type", *objInList.EntityType)
 						}
@@ -138,6 +159,7 @@ func dataSourceNsxtPolicyRealizationInfoRead(d *schema.ResourceData, m interface
 						return realizationResult, state, nil
 					} else if (objInList.EntityType != nil) && (*objInList.EntityType == entityType) {
 						d.Set("state", state)
+						setPolicyRealizationAlarmsInSchema(d, objInList.Alarms)
 						if objInList.RealizationSpecificIdentifier == nil {
 							d.Set("realized_id", "")
 						} else {
@@ -149,6 +171,7 @@ func dataSourceNsxtPolicyRealizationInfoRead(d *schema.ResourceData, m interface
 				// Realization info not found yet
 				d.Set("state", "UNKNOWN")
 				d.Set("realized_id", "")
+				setPolicyRealizationAlarmsInSchema(d, nil)
 				return realizationResult, "UNKNOWN", nil
 			}
 			return realizationResult, "", realizationError
@@ -163,3 +186,26 @@ func dataSourceNsxtPolicyRealizationInfoRead(d *schema.ResourceData, m interface
 	}
 	return nil
 }
+
+func setPolicyRealizationAlarmsInSchema(d *schema.ResourceData, alarms []model.PolicyAlarmResource) {
+	var alarmList []map[string]interface{}
+	for _, alarm := range alarms {
+		elem := make(map[string]interface{})
+		if alarm.Message != nil {
+			elem["message"] = *alarm.Message
+		}
+		if alarm.ErrorDetails != nil {
+			var details []string
+			if alarm.ErrorDetails.ErrorMessage != nil {
+				details = append(details, *alarm.ErrorDetails.ErrorMessage)
+			}
+			if alarm.ErrorDetails.Details != nil {
+				details = append(details, *alarm.ErrorDetails.Details)
+			}
+			elem["error_details"] = strings.Join(details, ": ")
+		}
+		alarmList = append(alarmList, elem)
+	}
+
+	d.Set("alarms", alarmList)
+}
@@ -35,6 +35,7 @@ func TestAccDataSourceNsxtPolicyRealizationInfo_tier1DataSource(t *testing.T) {
 					resource.TestCheckResourceAttr(testResourceName, "state", "REALIZED"),
 					resource.TestCheckResourceAttrSet(testResourceName, "entity_type"),
 					resource.TestCheckResourceAttrSet(testResourceName, "realized_id"),
+					resource.TestCheckResourceAttr(testResourceName, "alarms.#", "0"),
 					resource.TestCheckResourceAttrSet(testResourceName, "path"),
 				),
 			},
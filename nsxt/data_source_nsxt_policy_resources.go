@@ -0,0 +1,140 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+func dataSourceNsxtPolicyResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:        schema.TypeString,
+				Description: "Resource type of objects to search for, for example Segment",
+				Required:    true,
+			},
+			"tag": getTagsSchema(),
+			"items": {
+				Type:        schema.TypeList,
+				Description: "Objects that match the search criteria",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "Unique ID of this resource",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of this resource",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of this resource",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildPolicyResourcesByTagQuery(resourceType string, tags []model.Tag) string {
+	query := fmt.Sprintf("resource_type:%s AND marked_for_delete:false", resourceType)
+	for _, tag := range tags {
+		if tag.Scope != nil && *tag.Scope != "" {
+			query += fmt.Sprintf(" AND tags.scope:%s", strings.Replace(*tag.Scope, "/", "\\/", -1))
+		}
+		if tag.Tag != nil && *tag.Tag != "" {
+			query += fmt.Sprintf(" AND tags.tag:%s", strings.Replace(*tag.Tag, "/", "\\/", -1))
+		}
+	}
+	return query
+}
+
+// Search criteria matches scopes and tags separately, hence objects
+// are filtered again to ensure each requested scope + tag pair is present
+func policyResourceHasTags(resource model.PolicyResource, tags []model.Tag) bool {
+	for _, tag := range tags {
+		found := false
+		for _, objTag := range resource.Tags {
+			if (tag.Scope == nil || *tag.Scope == "" || (objTag.Scope != nil && *objTag.Scope == *tag.Scope)) &&
+				(tag.Tag == nil || *tag.Tag == "" || (objTag.Tag != nil && *objTag.Tag == *tag.Tag)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func dataSourceNsxtPolicyResourcesRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	resourceType := d.Get("resource_type").(string)
+	var tags []model.Tag
+	for _, tag := range d.Get("tag").(*schema.Set).List() {
+		tagData := tag.(map[string]interface{})
+		scope := tagData["scope"].(string)
+		tagValue := tagData["tag"].(string)
+		tags = append(tags, model.Tag{Scope: &scope, Tag: &tagValue})
+	}
+
+	query := buildPolicyResourcesByTagQuery(resourceType, tags)
+	var err error
+	var resultValues []*data.StructValue
+	if isPolicyGlobalManager(m) {
+		resultValues, err = searchGMPolicyResources(connector, query)
+	} else {
+		resultValues, err = searchLMPolicyResources(connector, query)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while searching %s objects: %v", resourceType, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	var itemList []map[string]interface{}
+	for _, result := range resultValues {
+		dataValue, errors := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errors) > 0 {
+			return errors[0]
+		}
+		policyResource := dataValue.(model.PolicyResource)
+		if policyResource.ResourceType == nil || *policyResource.ResourceType != resourceType {
+			continue
+		}
+		if !policyResourceHasTags(policyResource, tags) {
+			continue
+		}
+
+		elem := make(map[string]interface{})
+		elem["id"] = policyResource.Id
+		elem["display_name"] = policyResource.DisplayName
+		elem["path"] = policyResource.Path
+		itemList = append(itemList, elem)
+	}
+
+	err = d.Set("items", itemList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,72 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyResources_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_resources.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// Create the objects first, so that they are indexed for search
+				Config: testAccNsxtPolicyResourcesGroupsTemplate(name),
+			},
+			{
+				Config: testAccNsxtPolicyResourcesReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.display_name", name+"-prod"),
+					resource.TestCheckResourceAttrPair(testResourceName, "items.0.path", "nsxt_policy_group.prod", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "items.0.id", "nsxt_policy_group.prod", "nsx_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyResourcesGroupsTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "prod" {
+  display_name = "%s-prod"
+
+  tag {
+    scope = "%s"
+    tag   = "prod"
+  }
+}
+
+resource "nsxt_policy_group" "dev" {
+  display_name = "%s-dev"
+
+  tag {
+    scope = "%s"
+    tag   = "dev"
+  }
+}`, name, name, name, name)
+}
+
+func testAccNsxtPolicyResourcesReadTemplate(name string) string {
+	return testAccNsxtPolicyResourcesGroupsTemplate(name) + fmt.Sprintf(`
+
+data "nsxt_policy_resources" "test" {
+  resource_type = "Group"
+
+  tag {
+    scope = "%s"
+    tag   = "prod"
+  }
+
+  depends_on = [nsxt_policy_group.prod, nsxt_policy_group.dev]
+}`, name)
+}
@@ -84,10 +84,10 @@ func dataSourceNsxtPolicySegmentRealizationRead(d *schema.ResourceData, m interf
 			d.Set("state", state.State)
 			return state, *state.State, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 1 * time.Second,
-		Delay:      1 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Delay:   getPollInterval(m),
 	}
+	setPollBackoff(m, stateConf)
 	_, err := stateConf.WaitForState()
 	if err != nil {
 		return fmt.Errorf("Failed to get realization information for %s: %v", path, err)
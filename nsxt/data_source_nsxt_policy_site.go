@@ -4,7 +4,11 @@
 package nsxt
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
 func dataSourceNsxtPolicySite() *schema.Resource {
@@ -16,6 +20,30 @@ func dataSourceNsxtPolicySite() *schema.Resource {
 			"display_name": getDataSourceDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
+			"enforcement_point": {
+				Type:        schema.TypeList,
+				Description: "Enforcement points of this site",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the enforcement point",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the enforcement point",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the enforcement point",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -25,10 +53,33 @@ func dataSourceNsxtPolicySiteRead(d *schema.ResourceData, m interface{}) error {
 		return globalManagerOnlyError()
 	}
 
-	_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), true, "Site", nil)
+	connector := getPolicyConnector(m)
+	_, err := policyDataSourceResourceRead(d, connector, true, "Site", nil)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	query := fmt.Sprintf("resource_type:EnforcementPoint AND marked_for_delete:false AND %s", buildQueryStringFromMap(map[string]string{"parent_path": d.Get("path").(string)}))
+	results, err := searchGMPolicyResources(connector, query)
+	if err != nil {
+		return fmt.Errorf("Failed to read enforcement points of site %s: %v", d.Id(), err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	var enforcementPoints []map[string]interface{}
+	for _, result := range results {
+		dataValue, errs := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errs) > 0 {
+			return errs[0]
+		}
+		policyResource := dataValue.(model.PolicyResource)
+		elem := make(map[string]interface{})
+		elem["id"] = policyResource.Id
+		elem["display_name"] = policyResource.DisplayName
+		elem["path"] = policyResource.Path
+		enforcementPoints = append(enforcementPoints, elem)
+	}
+
+	return d.Set("enforcement_point", enforcementPoints)
 }
@@ -27,6 +27,8 @@ func TestAccDataSourceNsxtPolicySite_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
 					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "enforcement_point.#"),
+					resource.TestCheckResourceAttrSet(testResourceName, "enforcement_point.0.path"),
 				),
 			},
 		},
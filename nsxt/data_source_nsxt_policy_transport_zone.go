@@ -112,10 +112,14 @@ func dataSourceNsxtPolicyTransportZoneRead(d *schema.ResourceData, m interface{}
 	} else {
 		// Get by full name/prefix
 		includeMarkForDeleteObjectsParam := false
-		objList, err := client.List(defaultSite, getPolicyEnforcementPoint(m), nil, &includeMarkForDeleteObjectsParam, nil, nil, &includeMarkForDeleteObjectsParam, nil)
+		cacheKey := fmt.Sprintf("policy/%s/%s/transport-zones", defaultSite, getPolicyEnforcementPoint(m))
+		cached, err := getListCache(m).get(cacheKey, func() (interface{}, error) {
+			return client.List(defaultSite, getPolicyEnforcementPoint(m), nil, &includeMarkForDeleteObjectsParam, nil, nil, &includeMarkForDeleteObjectsParam, nil)
+		})
 		if err != nil {
 			return handleListError("TransportZone", err)
 		}
+		objList := cached.(lm_model.PolicyTransportZoneListResult)
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []lm_model.PolicyTransportZone
 		var prefixMatch []lm_model.PolicyTransportZone
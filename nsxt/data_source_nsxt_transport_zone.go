@@ -78,10 +78,14 @@ func dataSourceNsxtTransportZoneRead(d *schema.ResourceData, m interface{}) erro
 	} else {
 		// Get by full name/prefix
 		// TODO use 2nd parameter localVarOptionals for paging
-		objList, _, err := nsxClient.NetworkTransportApi.ListTransportZones(nsxClient.Context, nil)
+		cached, err := getListCache(m).get(transportZoneListCacheKey, func() (interface{}, error) {
+			objList, _, err := nsxClient.NetworkTransportApi.ListTransportZones(nsxClient.Context, nil)
+			return objList, err
+		})
 		if err != nil {
 			return fmt.Errorf("Error while reading transport zones: %v", err)
 		}
+		objList := cached.(manager.TransportZoneListResult)
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []manager.TransportZone
 		var prefixMatch []manager.TransportZone
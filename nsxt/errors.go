@@ -0,0 +1,190 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+)
+
+// Errors returned by NSX API wrappers. Callers can match them with errors.Is
+// rather than inspecting HTTP status codes directly.
+var (
+	ErrNotFound     = errors.New("object not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrConflict     = errors.New("conflict")
+	ErrNotSupported = errors.New("not supported with given provider settings")
+)
+
+// Error code reported by NSX manager when requested object does not exist
+const mpErrorCodeObjectNotFound = 202
+
+// MP SDK embeds response body in the error for 400 and 500 status codes,
+// in "Status: <status>, Body: <body>" form
+const mpAPIErrorBodySeparator = ", Body: "
+
+// Error details reported by NSX manager in response body. Kind holds one of
+// the exported errors above, if applicable, so that errors.Is matches it.
+type mpAPIError struct {
+	api.ApiError
+	kind error
+	err  error
+}
+
+func (e *mpAPIError) Error() string {
+	return fmt.Sprintf("%s (error code %d)", e.ErrorMessage, e.ErrorCode)
+}
+
+func (e *mpAPIError) Is(target error) bool {
+	return e.kind != nil && target == e.kind
+}
+
+func (e *mpAPIError) Unwrap() error {
+	return e.err
+}
+
+func parseMPAPIError(err error) *mpAPIError {
+	msg := err.Error()
+	idx := strings.Index(msg, mpAPIErrorBodySeparator)
+	if idx < 0 {
+		return nil
+	}
+
+	var details api.ApiError
+	if json.Unmarshal([]byte(msg[idx+len(mpAPIErrorBodySeparator):]), &details) != nil || details.ErrorCode == 0 {
+		return nil
+	}
+
+	return &mpAPIError{ApiError: details, err: err}
+}
+
+// Return error code reported by NSX manager, if available in the error
+func getMPAPIErrorCode(err error) (int64, bool) {
+	var apiErr *mpAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode, true
+	}
+
+	return 0, false
+}
+
+// Placeholder for sensitive values removed from error messages
+const redactedValue = "<redacted>"
+
+// Patterns of sensitive values that NSX might echo back in error details,
+// such as the request headers or the request body
+var sensitiveValuePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?is)-----BEGIN [A-Z ]*PRIVATE KEY-----.*?(-----END [A-Z ]*PRIVATE KEY-----|$)`),
+	regexp.MustCompile(`(?i)\b[0-9A-Z]{5}(-[0-9A-Z]{5}){4}\b`),
+}
+
+// Authorization header value, optionally preceded by the auth scheme
+var authorizationHeaderPattern = regexp.MustCompile(`(?i)(authorization\\?"?\s*[:=]\s*[\["\\]*(?:(?:basic|bearer|negotiate) )?)[^\s"\\,;}\]]+`)
+
+// Error with sensitive values removed from the message. The original error
+// is still available for errors.Is and errors.As.
+type sanitizedError struct {
+	msg string
+	err error
+}
+
+func (e *sanitizedError) Error() string {
+	return e.msg
+}
+
+func (e *sanitizedError) Unwrap() error {
+	return e.err
+}
+
+// Strip authorization headers, license keys and private keys from the error
+// message, so that they are not surfaced to the user or logged
+func sanitizeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	msg := err.Error()
+	sanitized := authorizationHeaderPattern.ReplaceAllString(msg, "${1}"+redactedValue)
+	for _, pattern := range sensitiveValuePatterns {
+		sanitized = pattern.ReplaceAllString(sanitized, redactedValue)
+	}
+	if sanitized == msg {
+		return err
+	}
+
+	return &sanitizedError{msg: sanitized, err: err}
+}
+
+// Wrap error returned from MP API call with exported error type that
+// corresponds to the response status code, if any. Sensitive values are
+// redacted from the error message.
+func wrapMPAPIError(resp *http.Response, err error) error {
+	err = sanitizeError(err)
+	if err == nil || resp == nil {
+		return err
+	}
+
+	var kind error
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		kind = ErrNotFound
+	case http.StatusUnauthorized, http.StatusForbidden:
+		kind = ErrUnauthorized
+	case http.StatusConflict:
+		kind = ErrConflict
+	}
+
+	if apiErr := parseMPAPIError(err); apiErr != nil {
+		if apiErr.ErrorCode == mpErrorCodeObjectNotFound {
+			kind = ErrNotFound
+		}
+		apiErr.kind = kind
+		return apiErr
+	}
+
+	if kind != nil {
+		return fmt.Errorf("%w: %v", kind, err)
+	}
+
+	return err
+}
+
+// Some NSX versions return 201 rather than 200 on create, and vice versa,
+// hence any 2xx status is considered successful
+func isSuccess(statusCode int) bool {
+	return statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices
+}
+
+// Initial delay between read retries on not found error, doubled on each attempt
+var readNotFoundRetryDelay = 500 * time.Millisecond
+
+// An object might not be visible on all manager nodes immediately after creation,
+// hence a read that follows create and fails with not found is retried a few times
+// before the error is returned to the caller. Reads on refresh are not retried, so
+// that objects deleted outside terraform are removed from state without delay.
+func retryOnNotFound(d *schema.ResourceData, m interface{}, readFunc func() error) error {
+	retries := 0
+	if d.IsNewResource() {
+		retries = getCommonProviderConfig(m).ReadNotFoundRetries
+	}
+	delay := readNotFoundRetryDelay
+	err := readFunc()
+	for attempt := 1; attempt <= retries && errors.Is(err, ErrNotFound); attempt++ {
+		log.Printf("[DEBUG] Object not found, retrying read in %v (attempt %d of %d)", delay, attempt, retries)
+		time.Sleep(delay)
+		delay *= 2
+		err = readFunc()
+	}
+
+	return err
+}
@@ -168,7 +168,7 @@ func getMtuSchema() *schema.Schema {
 		Type:         schema.TypeInt,
 		Optional:     true,
 		Description:  "Maximum transmission unit specifies the size of the largest packet that a network protocol can transmit",
-		ValidateFunc: validation.IntAtLeast(64),
+		ValidateFunc: validation.IntBetween(64, 9000),
 	}
 }
 
@@ -0,0 +1,22 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+)
+
+func TestGetMtuSchemaValidation(t *testing.T) {
+	validate := getMtuSchema().ValidateFunc
+	for _, mtu := range []int{64, 1500, 9000} {
+		if _, errs := validate(mtu, "mtu"); len(errs) > 0 {
+			t.Errorf("Expected MTU %d to be valid, got %v", mtu, errs)
+		}
+	}
+	for _, mtu := range []int{0, 63, 9001} {
+		if _, errs := validate(mtu, "mtu"); len(errs) == 0 {
+			t.Errorf("Expected MTU %d to be rejected", mtu)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// Cache for results of list API calls, shared by all operations of a single
+// provider instance. Data sources that look objects up by name list all
+// objects of given type, and within one apply same list is often requested
+// many times. Entries expire after TTL, and zero TTL disables the cache.
+// Cached results are shared between callers and must not be modified.
+type listCache struct {
+	ttl     time.Duration
+	mutex   sync.Mutex
+	entries map[string]listCacheEntry
+}
+
+type listCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+func newListCache(ttl time.Duration) *listCache {
+	return &listCache{
+		ttl:     ttl,
+		entries: make(map[string]listCacheEntry),
+	}
+}
+
+func (c *listCache) lookup(key string) (interface{}, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+func (c *listCache) store(key string, value interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.entries[key] = listCacheEntry{value: value, expires: time.Now().Add(c.ttl)}
+}
+
+// Drop cached result for given key, so that next get lists objects again.
+// Resources call this after modifying objects of the cached type.
+func (c *listCache) invalidate(key string) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.entries, key)
+}
+
+// Return cached result for given key, or call listFunc and cache its result.
+// Errors are not cached. Concurrent misses for same key might call listFunc
+// more than once, which is preferred over holding the lock during API call.
+func (c *listCache) get(key string, listFunc func() (interface{}, error)) (interface{}, error) {
+	if c == nil || c.ttl <= 0 {
+		return listFunc()
+	}
+
+	if value, ok := c.lookup(key); ok {
+		log.Printf("[DEBUG] Using cached list result for %s", key)
+		return value, nil
+	}
+
+	value, err := listFunc()
+	if err != nil {
+		return nil, err
+	}
+
+	c.store(key, value)
+	return value, nil
+}
+
+func getListCache(clients interface{}) *listCache {
+	return clients.(nsxtClients).ListCache
+}
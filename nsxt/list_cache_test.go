@@ -0,0 +1,98 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestListCache(t *testing.T) {
+	calls := 0
+	listFunc := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	cache := newListCache(time.Hour)
+	for i := 0; i < 3; i++ {
+		value, err := cache.get("key1", listFunc)
+		if err != nil || value.(int) != 1 {
+			t.Errorf("Expected cached value 1, got %v and error: %v", value, err)
+		}
+	}
+	value, _ := cache.get("key2", listFunc)
+	if value.(int) != 2 || calls != 2 {
+		t.Errorf("Expected separate entry for key2, got %v after %d calls", value, calls)
+	}
+
+	// errors are not cached
+	_, err := cache.get("key3", func() (interface{}, error) { return nil, fmt.Errorf("list failed") })
+	if err == nil {
+		t.Errorf("Expected list error")
+	}
+	value, err = cache.get("key3", listFunc)
+	if err != nil || value.(int) != 3 {
+		t.Errorf("Expected value 3 after failed list, got %v and error: %v", value, err)
+	}
+}
+
+func TestListCache_expiry(t *testing.T) {
+	calls := 0
+	listFunc := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	cache := newListCache(time.Millisecond)
+	cache.get("key1", listFunc)
+	time.Sleep(5 * time.Millisecond)
+	value, _ := cache.get("key1", listFunc)
+	if value.(int) != 2 {
+		t.Errorf("Expected expired entry to be refreshed, got %v", value)
+	}
+}
+
+func TestListCache_invalidate(t *testing.T) {
+	calls := 0
+	listFunc := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	cache := newListCache(time.Hour)
+	cache.get("key1", listFunc)
+	cache.get("key2", listFunc)
+	cache.invalidate("key1")
+	value, _ := cache.get("key1", listFunc)
+	if value.(int) != 3 {
+		t.Errorf("Expected invalidated entry to be refreshed, got %v", value)
+	}
+	value, _ = cache.get("key2", listFunc)
+	if value.(int) != 2 {
+		t.Errorf("Expected key2 to stay cached, got %v", value)
+	}
+
+	var nilCache *listCache
+	nilCache.invalidate("key1")
+}
+
+func TestListCache_disabled(t *testing.T) {
+	calls := 0
+	listFunc := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	var nilCache *listCache
+	for _, cache := range []*listCache{newListCache(0), nilCache} {
+		calls = 0
+		cache.get("key1", listFunc)
+		cache.get("key1", listFunc)
+		if calls != 2 {
+			t.Errorf("Expected no caching, got %d calls", calls)
+		}
+	}
+}
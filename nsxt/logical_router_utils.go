@@ -0,0 +1,108 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+// Router ports are deleted in this order, so that ports referenced by
+// other ports (such as tier0 link ports referenced from tier1) go last.
+// Port types not listed here are deleted at the end.
+var logicalRouterPortDeleteOrder = []string{
+	"LogicalRouterLinkPortOnTIER1",
+	"LogicalRouterDownLinkPort",
+	"LogicalRouterCentralizedServicePort",
+	"LogicalRouterLoopbackPort",
+	"LogicalRouterIPTunnelPort",
+	"LogicalRouterUpLinkPort",
+	"LogicalRouterLinkPortOnTIER0",
+}
+
+func getLogicalRouterForceDeleteSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Delete static routes and ports of this logical router before deleting the router",
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+func listLogicalRouterPorts(nsxClient *api.APIClient, routerID string) ([]manager.LogicalRouterPort, error) {
+	var ports []manager.LogicalRouterPort
+	lister := func(info *paginationInfo) error {
+		info.LocalVarOptionals["logicalRouterId"] = routerID
+		objList, _, err := nsxClient.LogicalRoutingAndServicesApi.ListLogicalRouterPorts(nsxClient.Context, info.LocalVarOptionals)
+		if err != nil {
+			return fmt.Errorf("Error while reading ports on router %s: %v", routerID, err)
+		}
+
+		info.PageCount = int64(len(objList.Results))
+		info.TotalCount = objList.ResultCount
+		info.Cursor = objList.Cursor
+		ports = append(ports, objList.Results...)
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	return ports, err
+}
+
+func getLogicalRouterPortDeleteRank(resourceType string) int {
+	for i, portType := range logicalRouterPortDeleteOrder {
+		if portType == resourceType {
+			return i
+		}
+	}
+
+	return len(logicalRouterPortDeleteOrder)
+}
+
+// Delete child objects that would otherwise block logical router deletion:
+// static routes first, since those may refer to router ports, and then ports
+func deleteLogicalRouterChildren(nsxClient *api.APIClient, routerID string) error {
+	routes, err := listLogicalRouterStaticRoutes(nsxClient, routerID)
+	if err != nil {
+		return err
+	}
+
+	for _, route := range routes {
+		log.Printf("[INFO] Deleting static route %s on router %s", route.Id, routerID)
+		resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteStaticRoute(nsxClient.Context, routerID, route.Id)
+		err = wrapMPAPIError(resp, err)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("Error during static route %s delete on router %s: %v", route.Id, routerID, err)
+		}
+	}
+
+	ports, err := listLogicalRouterPorts(nsxClient, routerID)
+	if err != nil {
+		return err
+	}
+
+	for rank := 0; rank <= len(logicalRouterPortDeleteOrder); rank++ {
+		for _, port := range ports {
+			if getLogicalRouterPortDeleteRank(port.ResourceType) != rank {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting %s %s on router %s", port.ResourceType, port.Id, routerID)
+			localVarOptionals := make(map[string]interface{})
+			localVarOptionals["force"] = true
+			resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteLogicalRouterPort(nsxClient.Context, port.Id, localVarOptionals)
+			err = wrapMPAPIError(resp, err)
+			if err != nil && !errors.Is(err, ErrNotFound) {
+				return fmt.Errorf("Error during %s %s delete on router %s: %v", port.ResourceType, port.Id, routerID, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceNsxtLogicalTier1RouterDelete_force(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/session/create":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/logical-routers/router1/routing/static-routes":
+			fmt.Fprint(w, `{"result_count": 1, "results": [{"id": "route1", "network": "4.4.4.0/24"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/logical-router-ports":
+			if r.URL.Query().Get("logical_router_id") != "router1" {
+				t.Errorf("Expected ports to be filtered by router, got %s", r.URL.RawQuery)
+			}
+			// Downlink port is listed first, but link port is expected to be deleted first
+			fmt.Fprint(w, `{"result_count": 2, "results": [
+			  {"id": "port2", "resource_type": "LogicalRouterDownLinkPort", "logical_router_id": "router1"},
+			  {"id": "port1", "resource_type": "LogicalRouterLinkPortOnTIER1", "logical_router_id": "router1"}]}`)
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/api/v1/"))
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+	m := nsxtClients{NsxtClient: client}
+
+	d := schema.TestResourceDataRaw(t, resourceNsxtLogicalTier1Router().Schema, map[string]interface{}{
+		"force_delete": true,
+	})
+	d.SetId("router1")
+	err := resourceNsxtLogicalTier1RouterDelete(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"logical-routers/router1/routing/static-routes/route1",
+		"logical-router-ports/port1",
+		"logical-router-ports/port2",
+		"logical-routers/router1",
+	}
+	if strings.Join(deleted, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected delete order %v, got %v", expected, deleted)
+	}
+
+	// Without force_delete, only the router itself is deleted
+	deleted = nil
+	d = schema.TestResourceDataRaw(t, resourceNsxtLogicalTier1Router().Schema, map[string]interface{}{})
+	d.SetId("router1")
+	err = resourceNsxtLogicalTier1RouterDelete(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != 1 || deleted[0] != "logical-routers/router1" {
+		t.Errorf("Expected only router to be deleted, got %v", deleted)
+	}
+}
@@ -338,10 +338,19 @@ func getPolicySecurityPolicySchema(isIds bool) map[string]*schema.Schema {
 			Optional:    true,
 			Computed:    true,
 		},
-		"rule": getSecurityPolicyAndGatewayRulesSchema(false, isIds),
+		"rule":           getSecurityPolicyAndGatewayRulesSchema(false, isIds),
+		"scheduler_path": getPolicyPathSchema(false, false, "Path of firewall scheduler for time based policy"),
+		"refresh_on_revision_conflict": {
+			Type:        schema.TypeBool,
+			Description: "Retry update once with latest revision if policy was modified concurrently",
+			Optional:    true,
+			Default:     false,
+		},
 	}
 
 	if isIds {
+		delete(result, "refresh_on_revision_conflict")
+		delete(result, "scheduler_path")
 		delete(result, "category")
 		delete(result, "scope")
 		delete(result, "tcp_strict")
@@ -350,6 +359,18 @@ func getPolicySecurityPolicySchema(isIds bool) map[string]*schema.Schema {
 	return result
 }
 
+func getPolicySchedulerPathFromSchema(d *schema.ResourceData) (*string, error) {
+	schedulerPath := d.Get("scheduler_path").(string)
+	if schedulerPath == "" {
+		return nil, nil
+	}
+	if !nsxVersionHigherOrEqual("3.1.0") {
+		return nil, fmt.Errorf("scheduler_path requires NSX version 3.1.0 or higher")
+	}
+
+	return &schedulerPath, nil
+}
+
 func setPolicyRulesInSchema(d *schema.ResourceData, rules []model.Rule) error {
 	var rulesList []map[string]interface{}
 	for _, rule := range rules {
@@ -375,14 +396,7 @@ func setPolicyRulesInSchema(d *schema.ResourceData, rules []model.Rule) error {
 		elem["nsx_id"] = rule.Id
 		elem["rule_id"] = rule.RuleId
 
-		var tagList []map[string]string
-		for _, tag := range rule.Tags {
-			tags := make(map[string]string)
-			tags["scope"] = *tag.Scope
-			tags["tag"] = *tag.Tag
-			tagList = append(tagList, tags)
-		}
-		elem["tag"] = tagList
+		elem["tag"] = initPolicyTagsSet(rule.Tags)
 
 		rulesList = append(rulesList, elem)
 	}
@@ -391,7 +405,10 @@ func setPolicyRulesInSchema(d *schema.ResourceData, rules []model.Rule) error {
 }
 
 func getPolicyRulesFromSchema(d *schema.ResourceData, setNsxID bool) []model.Rule {
-	rules := d.Get("rule").([]interface{})
+	return getPolicyRulesFromList(d.Get("rule").([]interface{}), setNsxID)
+}
+
+func getPolicyRulesFromList(rules []interface{}, setNsxID bool) []model.Rule {
 	var ruleList []model.Rule
 	seq := 0
 	for _, rule := range rules {
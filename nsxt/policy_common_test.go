@@ -0,0 +1,86 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestPolicyRuleTagsRoundTrip(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNsxtPolicySecurityPolicy().Schema, map[string]interface{}{
+		"rule": []interface{}{
+			map[string]interface{}{
+				"display_name": "rule1",
+				"tag": []interface{}{
+					map[string]interface{}{"scope": "owner", "tag": "team1"},
+					map[string]interface{}{"scope": "ticket", "tag": "1234"},
+				},
+			},
+		},
+	})
+
+	rules := getPolicyRulesFromSchema(d, false)
+	if len(rules) != 1 || len(rules[0].Tags) != 2 {
+		t.Fatalf("Expected rule with 2 tags, got %v", rules)
+	}
+
+	// Tag without scope is expected to be restored with empty scope
+	tag := "untracked"
+	rules[0].Tags = append(rules[0].Tags, model.Tag{Tag: &tag})
+	err := setPolicyRulesInSchema(d, rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := getPolicyTagsFromSet(d.Get("rule.0.tag").(*schema.Set))
+	if len(tags) != 3 {
+		t.Fatalf("Expected 3 rule tags, got %v", tags)
+	}
+	restored := make(map[string]string)
+	for _, tag := range tags {
+		restored[*tag.Scope] = *tag.Tag
+	}
+	if restored["owner"] != "team1" || restored["ticket"] != "1234" || restored[""] != "untracked" {
+		t.Errorf("Unexpected rule tags restored: %v", restored)
+	}
+}
+
+func TestPolicyRuleProfilesRoundTrip(t *testing.T) {
+	profile := "/infra/context-profiles/DNS"
+	d := schema.TestResourceDataRaw(t, resourceNsxtPolicySecurityPolicy().Schema, map[string]interface{}{
+		"rule": []interface{}{
+			map[string]interface{}{
+				"display_name": "rule1",
+				"profiles":     []interface{}{profile},
+			},
+			map[string]interface{}{
+				"display_name": "rule2",
+			},
+		},
+	})
+
+	rules := getPolicyRulesFromSchema(d, false)
+	if len(rules) != 2 || len(rules[0].Profiles) != 1 || rules[0].Profiles[0] != profile {
+		t.Fatalf("Expected context profile on first rule, got %v", rules)
+	}
+	// Empty profiles are sent as ANY
+	if len(rules[1].Profiles) != 1 || rules[1].Profiles[0] != "ANY" {
+		t.Fatalf("Expected ANY profile on second rule, got %v", rules[1].Profiles)
+	}
+
+	err := setPolicyRulesInSchema(d, rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	profiles := interface2StringList(d.Get("rule.0.profiles").(*schema.Set).List())
+	if len(profiles) != 1 || profiles[0] != profile {
+		t.Errorf("Expected context profile to be restored, got %v", profiles)
+	}
+	if d.Get("rule.1.profiles").(*schema.Set).Len() != 0 {
+		t.Errorf("Expected ANY profile to be restored as empty set, got %v", d.Get("rule.1.profiles"))
+	}
+}
@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	stderrors "errors"
 	"fmt"
 	"log"
 
@@ -139,6 +140,50 @@ func isNotFoundError(err error) bool {
 	return false
 }
 
+// NSX error code for stale _revision, reported with 412 Precondition Failed
+const policyRevisionMismatchErrorCode = 604
+
+// Extract NSX error code from vAPI error data, if present
+func getVapiErrorCode(apiErrorDataValue *data.StructValue) (int64, bool) {
+	if apiErrorDataValue == nil {
+		return 0, false
+	}
+	var typeConverter = bindings.NewTypeConverter()
+	typeConverter.SetMode(bindings.REST)
+	data, err := typeConverter.ConvertToGolang(apiErrorDataValue, model.ApiErrorBindingType())
+	if err != nil {
+		return 0, false
+	}
+	apiError := data.(model.ApiError)
+	if apiError.ErrorCode == nil {
+		return 0, false
+	}
+	return *apiError.ErrorCode, true
+}
+
+// Revision mismatch indicates the object was modified since it was last read
+func isRevisionConflictError(err error) bool {
+	if _, ok := err.(errors.ConcurrentChange); ok {
+		return true
+	}
+	if vapiError, ok := err.(errors.InvalidRequest); ok {
+		code, found := getVapiErrorCode(vapiError.Data)
+		return found && code == policyRevisionMismatchErrorCode
+	}
+
+	return false
+}
+
+func handleRevisionConflictError(resourceType string, resourceID string, err error) error {
+	if !isRevisionConflictError(err) {
+		return handleUpdateError(resourceType, resourceID, err)
+	}
+
+	msg := fmt.Sprintf("Failed to update %s %s: object was modified outside of this configuration since it was last read. Please refresh the state and review the plan before applying again, or set refresh_on_revision_conflict to retry with latest revision", resourceType, resourceID)
+	log.Printf("[ERROR]: %s", msg)
+	return stderrors.New(msg)
+}
+
 func handleCreateError(resourceType string, resourceID string, err error) error {
 	msg := fmt.Sprintf("Failed to create %s %s", resourceType, resourceID)
 	return logAPIError(msg, err)
@@ -0,0 +1,78 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+)
+
+// Helpers for policy LB monitor profiles, which share single polymorphic API
+func resourceNsxtPolicyLBMonitorProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func patchNsxtPolicyLBMonitorProfile(connector *client.RestConnector, id string, obj interface{}, bindingType bindings.BindingType) error {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	dataValue, errs := converter.ConvertToVapi(obj, bindingType)
+	if errs != nil {
+		return errs[0]
+	}
+
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+	return client.Patch(id, dataValue.(*data.StructValue))
+}
+
+func readNsxtPolicyLBMonitorProfile(connector *client.RestConnector, id string, bindingType bindings.BindingType) (interface{}, error) {
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	result, errs := converter.ConvertToGolang(obj, bindingType)
+	if errs != nil {
+		return nil, errs[0]
+	}
+
+	return result, nil
+}
+
+func resourceNsxtPolicyLBMonitorProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBMonitorProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultLbMonitorProfilesClient(connector)
+
+	force := true
+	err := client.Delete(id, &force)
+	if err != nil {
+		return handleDeleteError("LBMonitorProfile", id, err)
+	}
+
+	return nil
+}
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -223,7 +222,7 @@ func commaSeparatedStringToStringList(commaString string) []string {
 	return strList
 }
 
-func nsxtPolicyWaitForRealizationStateConf(connector *client.RestConnector, d *schema.ResourceData, realizedEntityPath string) *resource.StateChangeConf {
+func nsxtPolicyWaitForRealizationStateConf(connector *client.RestConnector, d *schema.ResourceData, m interface{}, realizedEntityPath string) *resource.StateChangeConf {
 	client := realized_state.NewDefaultRealizedEntitiesClient(connector)
 	pendingStates := []string{"UNKNOWN", "UNREALIZED"}
 	targetStates := []string{"REALIZED", "ERROR"}
@@ -245,10 +244,10 @@ func nsxtPolicyWaitForRealizationStateConf(connector *client.RestConnector, d *s
 			}
 			return nil, "", realizationError
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 1 * time.Second,
-		Delay:      1 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Delay:   getPollInterval(m),
 	}
+	setPollBackoff(m, stateConf)
 
 	return stateConf
 }
@@ -311,3 +310,94 @@ func retryUponTransientAPIError(operation func() error) error {
 
 	return err
 }
+
+// Update object with revision from state. If the revision is outdated and
+// refresh is allowed, latest revision is retrieved and update is retried once.
+// Note that retry overrides concurrent changes with local configuration.
+func updateUponRevisionConflict(d *schema.ResourceData, update func(revision int64) error, getRevision func() (int64, error)) error {
+	revision := int64(d.Get("revision").(int))
+	err := update(revision)
+	if err == nil || !isRevisionConflictError(err) || !d.Get("refresh_on_revision_conflict").(bool) {
+		return err
+	}
+
+	log.Printf("[INFO] Revision %d is outdated, retrying with latest revision", revision)
+	revision, getErr := getRevision()
+	if getErr != nil {
+		return getErr
+	}
+	return update(revision)
+}
+
+// Entity realized on manager from tier0 and tier1 gateways
+const realizedLogicalRouterEntityType = "RealizedLogicalRouter"
+
+// Resolve manager ID of the object realized from policy path, using realized state API.
+// Several entities can be realized from a single path (for example, gateway
+// is realized as logical router as well as router ports), hence the entity
+// type is expected. Empty entity type matches the first realized entity.
+func policyPathToMPID(connector *client.RestConnector, policyPath string, entityType string) (string, error) {
+	client := realized_state.NewDefaultRealizedEntitiesClient(connector)
+	realizationResult, err := client.List(policyPath, nil)
+	if err != nil {
+		return "", fmt.Errorf("Failed to resolve manager ID for policy path %s: %v", policyPath, err)
+	}
+
+	for _, objInList := range realizationResult.Results {
+		if entityType != "" && (objInList.EntityType == nil || *objInList.EntityType != entityType) {
+			continue
+		}
+		if objInList.RealizationSpecificIdentifier != nil && *objInList.RealizationSpecificIdentifier != "" {
+			return *objInList.RealizationSpecificIdentifier, nil
+		}
+	}
+
+	return "", fmt.Errorf("Failed to resolve manager ID for policy path %s: object is not realized", policyPath)
+}
+
+// Resolve policy path of the object realized with manager ID, using search API
+func mpIDToPolicyPath(connector *client.RestConnector, mpID string) (string, error) {
+	query := fmt.Sprintf("resource_type:GenericPolicyRealizedResource AND realization_specific_identifier:%s", mpID)
+	results, err := searchLMPolicyResources(connector, query)
+	if err != nil {
+		return "", fmt.Errorf("Failed to resolve policy path for manager ID %s: %v", mpID, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	for _, result := range results {
+		dataValue, errs := converter.ConvertToGolang(result, model.GenericPolicyRealizedResourceBindingType())
+		if len(errs) > 0 {
+			return "", fmt.Errorf("Failed to resolve policy path for manager ID %s: %v", mpID, errs[0])
+		}
+		realizedResource := dataValue.(model.GenericPolicyRealizedResource)
+		if realizedResource.RealizationSpecificIdentifier == nil || *realizedResource.RealizationSpecificIdentifier != mpID {
+			continue
+		}
+		for _, intentPath := range realizedResource.IntentPaths {
+			if isPolicyPath(intentPath) {
+				return intentPath, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("Failed to resolve policy path for manager ID %s: no policy object is realized with this ID", mpID)
+}
+
+// Accept either policy path or manager ID, and return policy path
+func getPolicyPathFromPathOrMPID(connector *client.RestConnector, pathOrID string) (string, error) {
+	if isPolicyPath(pathOrID) {
+		return pathOrID, nil
+	}
+
+	return mpIDToPolicyPath(connector, pathOrID)
+}
+
+// Accept either policy path or manager ID, and return manager ID
+func getMPIDFromPathOrMPID(connector *client.RestConnector, pathOrID string, entityType string) (string, error) {
+	if !isPolicyPath(pathOrID) {
+		return pathOrID, nil
+	}
+
+	return policyPathToMPID(connector, pathOrID, entityType)
+}
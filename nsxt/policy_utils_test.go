@@ -0,0 +1,213 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const testPolicyPath = "/infra/tier-1s/t1"
+const testMPID = "5f7e9a2c-0c3a-4b44-9d2a-1d8f3c6b7a10"
+const testMPPortID = "0b6a31d4-8e2f-4c77-a5d1-3e9c2f7b4d21"
+
+// Gateway is realized as several entities, with router port listed before the router
+var testPolicyRealizedEntities = fmt.Sprintf(`[
+  {"resource_type": "GenericPolicyRealizedResource", "state": "REALIZED", "entity_type": "RealizedLogicalRouterPort", "realization_specific_identifier": "%s"},
+  {"resource_type": "GenericPolicyRealizedResource", "state": "REALIZED", "entity_type": "RealizedLogicalRouter", "realization_specific_identifier": "%s"}]`, testMPPortID, testMPID)
+
+func newTestPolicyRealizationServer(t *testing.T, realized bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		results := "[]"
+		count := 0
+		switch r.URL.Path {
+		case "/policy/api/v1/infra/realized-state/realized-entities":
+			if realized && r.URL.Query().Get("intent_path") == testPolicyPath {
+				results = testPolicyRealizedEntities
+				count = 2
+			}
+		case "/policy/api/v1/search/query":
+			if realized {
+				results = fmt.Sprintf(`[{"resource_type": "GenericPolicyRealizedResource", "state": "REALIZED", "realization_specific_identifier": "%s", "intent_paths": ["%s"]}]`, testMPID, testPolicyPath)
+				count = 1
+			}
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"results": %s, "result_count": %d}`, results, count)
+	}))
+}
+
+func TestPolicyPathMPIDConversion(t *testing.T) {
+	server := newTestPolicyRealizationServer(t, true)
+	defer server.Close()
+	connector := client.NewRestConnector(server.URL, *server.Client())
+
+	mpID, err := policyPathToMPID(connector, testPolicyPath, realizedLogicalRouterEntityType)
+	if err != nil {
+		t.Fatalf("Failed to resolve manager ID: %v", err)
+	}
+	if mpID != testMPID {
+		t.Errorf("Expected manager ID %s, got %s", testMPID, mpID)
+	}
+
+	_, err = policyPathToMPID(connector, testPolicyPath, "RealizedLogicalSwitch")
+	if err == nil {
+		t.Errorf("Expected error when no entity of given type is realized")
+	}
+
+	path, err := mpIDToPolicyPath(connector, testMPID)
+	if err != nil {
+		t.Fatalf("Failed to resolve policy path: %v", err)
+	}
+	if path != testPolicyPath {
+		t.Errorf("Expected policy path %s, got %s", testPolicyPath, path)
+	}
+
+	for _, value := range []string{testPolicyPath, testMPID} {
+		path, err = getPolicyPathFromPathOrMPID(connector, value)
+		if err != nil || path != testPolicyPath {
+			t.Errorf("Expected policy path %s for %s, got %s (%v)", testPolicyPath, value, path, err)
+		}
+		mpID, err = getMPIDFromPathOrMPID(connector, value, realizedLogicalRouterEntityType)
+		if err != nil || mpID != testMPID {
+			t.Errorf("Expected manager ID %s for %s, got %s (%v)", testMPID, value, mpID, err)
+		}
+	}
+}
+
+func TestPolicyPathMPIDConversion_notRealized(t *testing.T) {
+	server := newTestPolicyRealizationServer(t, false)
+	defer server.Close()
+	connector := client.NewRestConnector(server.URL, *server.Client())
+
+	_, err := policyPathToMPID(connector, testPolicyPath, realizedLogicalRouterEntityType)
+	if err == nil {
+		t.Errorf("Expected error when resolving manager ID for unrealized path")
+	}
+
+	_, err = mpIDToPolicyPath(connector, testMPID)
+	if err == nil {
+		t.Errorf("Expected error when resolving policy path for unknown manager ID")
+	}
+}
+
+// Error as returned by the runtime for 412 Precondition Failed with given NSX error code
+func newTestInvalidRequestError(t *testing.T, errorCode int64) errors.InvalidRequest {
+	message := "The object was modified by somebody else"
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	dataValue, errs := converter.ConvertToVapi(model.ApiError{ErrorCode: &errorCode, ErrorMessage: &message}, model.ApiErrorBindingType())
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	return errors.InvalidRequest{Data: dataValue.(*data.StructValue)}
+}
+
+func TestIsRevisionConflictError(t *testing.T) {
+	if !isRevisionConflictError(errors.ConcurrentChange{}) {
+		t.Errorf("Expected concurrent change to be a revision conflict")
+	}
+	if !isRevisionConflictError(newTestInvalidRequestError(t, policyRevisionMismatchErrorCode)) {
+		t.Errorf("Expected invalid request with revision mismatch code to be a revision conflict")
+	}
+	if isRevisionConflictError(newTestInvalidRequestError(t, 500012)) {
+		t.Errorf("Expected invalid request with other code not to be a revision conflict")
+	}
+	if isRevisionConflictError(errors.InvalidRequest{}) {
+		t.Errorf("Expected invalid request without data not to be a revision conflict")
+	}
+	if isRevisionConflictError(errors.NotFound{}) {
+		t.Errorf("Expected not found error not to be a revision conflict")
+	}
+}
+
+func TestUpdateUponRevisionConflict(t *testing.T) {
+	latestRevision := int64(5)
+	for _, conflictErr := range []error{errors.ConcurrentChange{}, newTestInvalidRequestError(t, policyRevisionMismatchErrorCode)} {
+		testUpdateUponRevisionConflict(t, latestRevision, conflictErr)
+	}
+}
+
+func testUpdateUponRevisionConflict(t *testing.T, latestRevision int64, conflictErr error) {
+	for _, refresh := range []bool{false, true} {
+		d := schema.TestResourceDataRaw(t, getPolicySecurityPolicySchema(false), map[string]interface{}{
+			"refresh_on_revision_conflict": refresh,
+		})
+		d.Set("revision", 3)
+
+		var revisions []int64
+		update := func(revision int64) error {
+			revisions = append(revisions, revision)
+			if revision != latestRevision {
+				return conflictErr
+			}
+			return nil
+		}
+		getRevision := func() (int64, error) {
+			return latestRevision, nil
+		}
+
+		err := updateUponRevisionConflict(d, update, getRevision)
+		if refresh {
+			if err != nil || len(revisions) != 2 || revisions[1] != latestRevision {
+				t.Errorf("Expected retry with revision %d, got %v (%v)", latestRevision, revisions, err)
+			}
+			continue
+		}
+
+		if len(revisions) != 1 || !isRevisionConflictError(err) {
+			t.Errorf("Expected single attempt failing with revision conflict, got %v (%v)", revisions, err)
+		}
+	}
+}
+
+func TestHandleRevisionConflictError(t *testing.T) {
+	for _, conflictErr := range []error{errors.ConcurrentChange{}, newTestInvalidRequestError(t, policyRevisionMismatchErrorCode)} {
+		err := handleRevisionConflictError("Security Policy", "policy1", conflictErr)
+		if err == nil || !strings.Contains(err.Error(), "refresh_on_revision_conflict") {
+			t.Errorf("Expected revision conflict guidance for %v, got %v", conflictErr, err)
+		}
+	}
+}
+
+func TestGetPolicySchedulerPathFromSchema(t *testing.T) {
+	savedVersion := nsxVersion
+	defer func() { nsxVersion = savedVersion }()
+
+	schedulerPath := "/infra/firewall-schedulers/maintenance"
+	d := schema.TestResourceDataRaw(t, getPolicySecurityPolicySchema(false), map[string]interface{}{
+		"scheduler_path": schedulerPath,
+	})
+
+	nsxVersion = "3.0.0"
+	if _, err := getPolicySchedulerPathFromSchema(d); err == nil {
+		t.Errorf("Expected error for scheduler_path with NSX version %s", nsxVersion)
+	}
+
+	nsxVersion = "3.1.0"
+	path, err := getPolicySchedulerPathFromSchema(d)
+	if err != nil || path == nil || *path != schedulerPath {
+		t.Errorf("Expected scheduler path %s, got %v (%v)", schedulerPath, path, err)
+	}
+
+	d = schema.TestResourceDataRaw(t, getPolicySecurityPolicySchema(false), map[string]interface{}{})
+	path, err = getPolicySchedulerPathFromSchema(d)
+	if err != nil || path != nil {
+		t.Errorf("Expected no scheduler path when not configured, got %v (%v)", path, err)
+	}
+}
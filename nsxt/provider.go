@@ -4,15 +4,19 @@
 package nsxt
 
 import (
+	"compress/gzip"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -30,10 +34,15 @@ type commonProviderConfig struct {
 	RemoteAuth             bool
 	BearerToken            string
 	ToleratePartialSuccess bool
+	ReadNotFoundRetries    int
+	NamePrefix             string
+	PollInterval           time.Duration
 }
 
 type nsxtClients struct {
 	CommonConfig commonProviderConfig
+	// Cache for list results, shared by all operations of the provider
+	ListCache *listCache
 	// NSX Manager client - based on go-vmware-nsxt SDK
 	NsxtClient *api.APIClient
 	// Data for NSX Policy client - based on vsphere-automation-sdk-go SDK
@@ -167,6 +176,7 @@ func Provider() *schema.Provider {
 				Optional:      true,
 				Description:   "license keys",
 				ConflictsWith: []string{"vmc_token"},
+				Sensitive:     true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 					ValidateFunc: validation.StringMatch(
@@ -193,6 +203,60 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_CA", nil),
 			},
+			"max_idle_connections": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of idle (keep-alive) connections to NSX manager",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_MAX_IDLE_CONNECTIONS", 100),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"idle_connection_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Time in seconds an idle connection to NSX manager remains open before closing itself",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_IDLE_CONNECTION_TIMEOUT", 90),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"http_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Time limit in seconds for each request sent to NSX manager, 0 means no limit",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_HTTP_TIMEOUT_SECONDS", 60),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"read_not_found_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Number of times to retry reading an object that was reported as not found right after creation",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_READ_NOT_FOUND_RETRIES", 3),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"cache_ttl_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Time in seconds to cache list results used by data sources, 0 disables the cache",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_CACHE_TTL_SECONDS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"realization_poll_interval_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Minimal interval in seconds between reads while waiting for object realization or deletion",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_REALIZATION_POLL_INTERVAL_SECONDS", 2),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"user_agent_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "String to append to User-Agent header of all requests",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_USER_AGENT_SUFFIX", ""),
+			},
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prefix for default display name of manager objects created without display_name. Default display name is the prefix followed by object ID",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_NAME_PREFIX", ""),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -207,6 +271,8 @@ func Provider() *schema.Provider {
 			"nsxt_ns_service":                       dataSourceNsxtNsService(),
 			"nsxt_ns_services":                      dataSourceNsxtNsServices(),
 			"nsxt_edge_cluster":                     dataSourceNsxtEdgeCluster(),
+			"nsxt_compute_manager":                  dataSourceNsxtComputeManager(),
+			"nsxt_compute_collection":               dataSourceNsxtComputeCollection(),
 			"nsxt_certificate":                      dataSourceNsxtCertificate(),
 			"nsxt_ip_pool":                          dataSourceNsxtIPPool(),
 			"nsxt_firewall_section":                 dataSourceNsxtFirewallSection(),
@@ -246,115 +312,150 @@ func Provider() *schema.Provider {
 			"nsxt_policy_bfd_profile":               dataSourceNsxtPolicyBfdProfile(),
 			"nsxt_policy_intrusion_service_profile": dataSourceNsxtPolicyIntrusionServiceProfile(),
 			"nsxt_policy_lb_service":                dataSourceNsxtPolicyLbService(),
+			"nsxt_policy_resources":                 dataSourceNsxtPolicyResources(),
+			"nsxt_license_usage":                    dataSourceNsxtLicenseUsage(),
+			"nsxt_fabric_node":                      dataSourceNsxtFabricNode(),
+			"nsxt_licenses":                         dataSourceNsxtLicenses(),
+			"nsxt_license_compliance":               dataSourceNsxtLicenseCompliance(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"nsxt_dhcp_relay_profile":                      resourceNsxtDhcpRelayProfile(),
-			"nsxt_dhcp_relay_service":                      resourceNsxtDhcpRelayService(),
-			"nsxt_dhcp_server_profile":                     resourceNsxtDhcpServerProfile(),
-			"nsxt_logical_dhcp_server":                     resourceNsxtLogicalDhcpServer(),
-			"nsxt_dhcp_server_ip_pool":                     resourceNsxtDhcpServerIPPool(),
-			"nsxt_logical_switch":                          resourceNsxtLogicalSwitch(),
-			"nsxt_vlan_logical_switch":                     resourceNsxtVlanLogicalSwitch(),
-			"nsxt_logical_dhcp_port":                       resourceNsxtLogicalDhcpPort(),
-			"nsxt_logical_port":                            resourceNsxtLogicalPort(),
-			"nsxt_logical_tier0_router":                    resourceNsxtLogicalTier0Router(),
-			"nsxt_logical_tier1_router":                    resourceNsxtLogicalTier1Router(),
-			"nsxt_logical_router_centralized_service_port": resourceNsxtLogicalRouterCentralizedServicePort(),
-			"nsxt_logical_router_downlink_port":            resourceNsxtLogicalRouterDownLinkPort(),
-			"nsxt_logical_router_link_port_on_tier0":       resourceNsxtLogicalRouterLinkPortOnTier0(),
-			"nsxt_logical_router_link_port_on_tier1":       resourceNsxtLogicalRouterLinkPortOnTier1(),
-			"nsxt_ip_discovery_switching_profile":          resourceNsxtIPDiscoverySwitchingProfile(),
-			"nsxt_mac_management_switching_profile":        resourceNsxtMacManagementSwitchingProfile(),
-			"nsxt_qos_switching_profile":                   resourceNsxtQosSwitchingProfile(),
-			"nsxt_spoofguard_switching_profile":            resourceNsxtSpoofGuardSwitchingProfile(),
-			"nsxt_switch_security_switching_profile":       resourceNsxtSwitchSecuritySwitchingProfile(),
-			"nsxt_l4_port_set_ns_service":                  resourceNsxtL4PortSetNsService(),
-			"nsxt_algorithm_type_ns_service":               resourceNsxtAlgorithmTypeNsService(),
-			"nsxt_icmp_type_ns_service":                    resourceNsxtIcmpTypeNsService(),
-			"nsxt_igmp_type_ns_service":                    resourceNsxtIgmpTypeNsService(),
-			"nsxt_ether_type_ns_service":                   resourceNsxtEtherTypeNsService(),
-			"nsxt_ip_protocol_ns_service":                  resourceNsxtIPProtocolNsService(),
-			"nsxt_ns_service_group":                        resourceNsxtNsServiceGroup(),
-			"nsxt_ns_group":                                resourceNsxtNsGroup(),
-			"nsxt_firewall_section":                        resourceNsxtFirewallSection(),
-			"nsxt_nat_rule":                                resourceNsxtNatRule(),
-			"nsxt_ip_block":                                resourceNsxtIPBlock(),
-			"nsxt_ip_block_subnet":                         resourceNsxtIPBlockSubnet(),
-			"nsxt_ip_pool":                                 resourceNsxtIPPool(),
-			"nsxt_ip_pool_allocation_ip_address":           resourceNsxtIPPoolAllocationIPAddress(),
-			"nsxt_ip_set":                                  resourceNsxtIPSet(),
-			"nsxt_static_route":                            resourceNsxtStaticRoute(),
-			"nsxt_vm_tags":                                 resourceNsxtVMTags(),
-			"nsxt_lb_icmp_monitor":                         resourceNsxtLbIcmpMonitor(),
-			"nsxt_lb_tcp_monitor":                          resourceNsxtLbTCPMonitor(),
-			"nsxt_lb_udp_monitor":                          resourceNsxtLbUDPMonitor(),
-			"nsxt_lb_http_monitor":                         resourceNsxtLbHTTPMonitor(),
-			"nsxt_lb_https_monitor":                        resourceNsxtLbHTTPSMonitor(),
-			"nsxt_lb_passive_monitor":                      resourceNsxtLbPassiveMonitor(),
-			"nsxt_lb_pool":                                 resourceNsxtLbPool(),
-			"nsxt_lb_tcp_virtual_server":                   resourceNsxtLbTCPVirtualServer(),
-			"nsxt_lb_udp_virtual_server":                   resourceNsxtLbUDPVirtualServer(),
-			"nsxt_lb_http_virtual_server":                  resourceNsxtLbHTTPVirtualServer(),
-			"nsxt_lb_http_forwarding_rule":                 resourceNsxtLbHTTPForwardingRule(),
-			"nsxt_lb_http_request_rewrite_rule":            resourceNsxtLbHTTPRequestRewriteRule(),
-			"nsxt_lb_http_response_rewrite_rule":           resourceNsxtLbHTTPResponseRewriteRule(),
-			"nsxt_lb_cookie_persistence_profile":           resourceNsxtLbCookiePersistenceProfile(),
-			"nsxt_lb_source_ip_persistence_profile":        resourceNsxtLbSourceIPPersistenceProfile(),
-			"nsxt_lb_client_ssl_profile":                   resourceNsxtLbClientSslProfile(),
-			"nsxt_lb_server_ssl_profile":                   resourceNsxtLbServerSslProfile(),
-			"nsxt_lb_service":                              resourceNsxtLbService(),
-			"nsxt_lb_fast_tcp_application_profile":         resourceNsxtLbFastTCPApplicationProfile(),
-			"nsxt_lb_fast_udp_application_profile":         resourceNsxtLbFastUDPApplicationProfile(),
-			"nsxt_lb_http_application_profile":             resourceNsxtLbHTTPApplicationProfile(),
-			"nsxt_policy_tier1_gateway":                    resourceNsxtPolicyTier1Gateway(),
-			"nsxt_policy_tier1_gateway_interface":          resourceNsxtPolicyTier1GatewayInterface(),
-			"nsxt_policy_tier0_gateway":                    resourceNsxtPolicyTier0Gateway(),
-			"nsxt_policy_tier0_gateway_interface":          resourceNsxtPolicyTier0GatewayInterface(),
-			"nsxt_policy_tier0_gateway_ha_vip_config":      resourceNsxtPolicyTier0GatewayHAVipConfig(),
-			"nsxt_policy_group":                            resourceNsxtPolicyGroup(),
-			"nsxt_policy_domain":                           resourceNsxtPolicyDomain(),
-			"nsxt_policy_security_policy":                  resourceNsxtPolicySecurityPolicy(),
-			"nsxt_policy_service":                          resourceNsxtPolicyService(),
-			"nsxt_policy_gateway_policy":                   resourceNsxtPolicyGatewayPolicy(),
-			"nsxt_policy_predefined_gateway_policy":        resourceNsxtPolicyPredefinedGatewayPolicy(),
-			"nsxt_policy_predefined_security_policy":       resourceNsxtPolicyPredefinedSecurityPolicy(),
-			"nsxt_policy_segment":                          resourceNsxtPolicySegment(),
-			"nsxt_policy_vlan_segment":                     resourceNsxtPolicyVlanSegment(),
-			"nsxt_policy_fixed_segment":                    resourceNsxtPolicyFixedSegment(),
-			"nsxt_policy_static_route":                     resourceNsxtPolicyStaticRoute(),
-			"nsxt_policy_gateway_prefix_list":              resourceNsxtPolicyGatewayPrefixList(),
-			"nsxt_policy_vm_tags":                          resourceNsxtPolicyVMTags(),
-			"nsxt_policy_nat_rule":                         resourceNsxtPolicyNATRule(),
-			"nsxt_policy_ip_block":                         resourceNsxtPolicyIPBlock(),
-			"nsxt_policy_lb_pool":                          resourceNsxtPolicyLBPool(),
-			"nsxt_policy_ip_pool":                          resourceNsxtPolicyIPPool(),
-			"nsxt_policy_ip_pool_block_subnet":             resourceNsxtPolicyIPPoolBlockSubnet(),
-			"nsxt_policy_ip_pool_static_subnet":            resourceNsxtPolicyIPPoolStaticSubnet(),
-			"nsxt_policy_lb_service":                       resourceNsxtPolicyLBService(),
-			"nsxt_policy_lb_virtual_server":                resourceNsxtPolicyLBVirtualServer(),
-			"nsxt_policy_ip_address_allocation":            resourceNsxtPolicyIPAddressAllocation(),
-			"nsxt_policy_bgp_neighbor":                     resourceNsxtPolicyBgpNeighbor(),
-			"nsxt_policy_bgp_config":                       resourceNsxtPolicyBgpConfig(),
-			"nsxt_policy_dhcp_relay":                       resourceNsxtPolicyDhcpRelayConfig(),
-			"nsxt_policy_dhcp_server":                      resourceNsxtPolicyDhcpServer(),
-			"nsxt_policy_context_profile":                  resourceNsxtPolicyContextProfile(),
-			"nsxt_policy_dhcp_v4_static_binding":           resourceNsxtPolicyDhcpV4StaticBinding(),
-			"nsxt_policy_dhcp_v6_static_binding":           resourceNsxtPolicyDhcpV6StaticBinding(),
-			"nsxt_policy_dns_forwarder_zone":               resourceNsxtPolicyDNSForwarderZone(),
-			"nsxt_policy_gateway_dns_forwarder":            resourceNsxtPolicyGatewayDNSForwarder(),
-			"nsxt_policy_gateway_community_list":           resourceNsxtPolicyGatewayCommunityList(),
-			"nsxt_policy_gateway_route_map":                resourceNsxtPolicyGatewayRouteMap(),
-			"nsxt_policy_intrusion_service_policy":         resourceNsxtPolicyIntrusionServicePolicy(),
-			"nsxt_policy_static_route_bfd_peer":            resourceNsxtPolicyStaticRouteBfdPeer(),
-			"nsxt_policy_intrusion_service_profile":        resourceNsxtPolicyIntrusionServiceProfile(),
-			"nsxt_policy_evpn_tenant":                      resourceNsxtPolicyEvpnTenant(),
-			"nsxt_policy_evpn_config":                      resourceNsxtPolicyEvpnConfig(),
-			"nsxt_policy_evpn_tunnel_endpoint":             resourceNsxtPolicyEvpnTunnelEndpoint(),
-			"nsxt_policy_qos_profile":                      resourceNsxtPolicyQosProfile(),
-			"nsxt_policy_ospf_config":                      resourceNsxtPolicyOspfConfig(),
-			"nsxt_policy_ospf_area":                        resourceNsxtPolicyOspfArea(),
-			"nsxt_policy_gateway_redistribution_config":    resourceNsxtPolicyGatewayRedistributionConfig(),
+			"nsxt_dhcp_relay_profile":                          resourceNsxtDhcpRelayProfile(),
+			"nsxt_dhcp_relay_service":                          resourceNsxtDhcpRelayService(),
+			"nsxt_dhcp_server_profile":                         resourceNsxtDhcpServerProfile(),
+			"nsxt_logical_dhcp_server":                         resourceNsxtLogicalDhcpServer(),
+			"nsxt_dhcp_server_ip_pool":                         resourceNsxtDhcpServerIPPool(),
+			"nsxt_logical_switch":                              resourceNsxtLogicalSwitch(),
+			"nsxt_vlan_logical_switch":                         resourceNsxtVlanLogicalSwitch(),
+			"nsxt_logical_dhcp_port":                           resourceNsxtLogicalDhcpPort(),
+			"nsxt_logical_port":                                resourceNsxtLogicalPort(),
+			"nsxt_logical_tier0_router":                        resourceNsxtLogicalTier0Router(),
+			"nsxt_logical_tier1_router":                        resourceNsxtLogicalTier1Router(),
+			"nsxt_logical_router_centralized_service_port":     resourceNsxtLogicalRouterCentralizedServicePort(),
+			"nsxt_logical_router_downlink_port":                resourceNsxtLogicalRouterDownLinkPort(),
+			"nsxt_logical_router_link_port_on_tier0":           resourceNsxtLogicalRouterLinkPortOnTier0(),
+			"nsxt_logical_router_link_port_on_tier1":           resourceNsxtLogicalRouterLinkPortOnTier1(),
+			"nsxt_ip_discovery_switching_profile":              resourceNsxtIPDiscoverySwitchingProfile(),
+			"nsxt_mac_management_switching_profile":            resourceNsxtMacManagementSwitchingProfile(),
+			"nsxt_qos_switching_profile":                       resourceNsxtQosSwitchingProfile(),
+			"nsxt_spoofguard_switching_profile":                resourceNsxtSpoofGuardSwitchingProfile(),
+			"nsxt_switch_security_switching_profile":           resourceNsxtSwitchSecuritySwitchingProfile(),
+			"nsxt_l4_port_set_ns_service":                      resourceNsxtL4PortSetNsService(),
+			"nsxt_algorithm_type_ns_service":                   resourceNsxtAlgorithmTypeNsService(),
+			"nsxt_icmp_type_ns_service":                        resourceNsxtIcmpTypeNsService(),
+			"nsxt_igmp_type_ns_service":                        resourceNsxtIgmpTypeNsService(),
+			"nsxt_ether_type_ns_service":                       resourceNsxtEtherTypeNsService(),
+			"nsxt_ip_protocol_ns_service":                      resourceNsxtIPProtocolNsService(),
+			"nsxt_ns_service_group":                            resourceNsxtNsServiceGroup(),
+			"nsxt_ns_group":                                    resourceNsxtNsGroup(),
+			"nsxt_firewall_section":                            resourceNsxtFirewallSection(),
+			"nsxt_nat_rule":                                    resourceNsxtNatRule(),
+			"nsxt_ip_block":                                    resourceNsxtIPBlock(),
+			"nsxt_ip_block_subnet":                             resourceNsxtIPBlockSubnet(),
+			"nsxt_ip_pool":                                     resourceNsxtIPPool(),
+			"nsxt_ip_pool_allocation_ip_address":               resourceNsxtIPPoolAllocationIPAddress(),
+			"nsxt_ip_set":                                      resourceNsxtIPSet(),
+			"nsxt_static_route":                                resourceNsxtStaticRoute(),
+			"nsxt_vm_tags":                                     resourceNsxtVMTags(),
+			"nsxt_edge_transport_node":                         resourceNsxtEdgeTransportNode(),
+			"nsxt_transport_node":                              resourceNsxtTransportNode(),
+			"nsxt_transport_zone":                              resourceNsxtTransportZone(),
+			"nsxt_uplink_host_switch_profile":                  resourceNsxtUplinkHostSwitchProfile(),
+			"nsxt_lb_icmp_monitor":                             resourceNsxtLbIcmpMonitor(),
+			"nsxt_lb_tcp_monitor":                              resourceNsxtLbTCPMonitor(),
+			"nsxt_lb_udp_monitor":                              resourceNsxtLbUDPMonitor(),
+			"nsxt_lb_http_monitor":                             resourceNsxtLbHTTPMonitor(),
+			"nsxt_lb_https_monitor":                            resourceNsxtLbHTTPSMonitor(),
+			"nsxt_lb_passive_monitor":                          resourceNsxtLbPassiveMonitor(),
+			"nsxt_lb_pool":                                     resourceNsxtLbPool(),
+			"nsxt_lb_tcp_virtual_server":                       resourceNsxtLbTCPVirtualServer(),
+			"nsxt_lb_udp_virtual_server":                       resourceNsxtLbUDPVirtualServer(),
+			"nsxt_lb_http_virtual_server":                      resourceNsxtLbHTTPVirtualServer(),
+			"nsxt_lb_http_forwarding_rule":                     resourceNsxtLbHTTPForwardingRule(),
+			"nsxt_lb_http_request_rewrite_rule":                resourceNsxtLbHTTPRequestRewriteRule(),
+			"nsxt_lb_http_response_rewrite_rule":               resourceNsxtLbHTTPResponseRewriteRule(),
+			"nsxt_lb_cookie_persistence_profile":               resourceNsxtLbCookiePersistenceProfile(),
+			"nsxt_lb_source_ip_persistence_profile":            resourceNsxtLbSourceIPPersistenceProfile(),
+			"nsxt_lb_client_ssl_profile":                       resourceNsxtLbClientSslProfile(),
+			"nsxt_lb_server_ssl_profile":                       resourceNsxtLbServerSslProfile(),
+			"nsxt_lb_service":                                  resourceNsxtLbService(),
+			"nsxt_lb_fast_tcp_application_profile":             resourceNsxtLbFastTCPApplicationProfile(),
+			"nsxt_lb_fast_udp_application_profile":             resourceNsxtLbFastUDPApplicationProfile(),
+			"nsxt_lb_http_application_profile":                 resourceNsxtLbHTTPApplicationProfile(),
+			"nsxt_policy_tier1_gateway":                        resourceNsxtPolicyTier1Gateway(),
+			"nsxt_policy_tier1_gateway_interface":              resourceNsxtPolicyTier1GatewayInterface(),
+			"nsxt_policy_tier0_gateway":                        resourceNsxtPolicyTier0Gateway(),
+			"nsxt_policy_tier0_gateway_interface":              resourceNsxtPolicyTier0GatewayInterface(),
+			"nsxt_policy_tier0_gateway_ha_vip_config":          resourceNsxtPolicyTier0GatewayHAVipConfig(),
+			"nsxt_policy_group":                                resourceNsxtPolicyGroup(),
+			"nsxt_policy_domain":                               resourceNsxtPolicyDomain(),
+			"nsxt_policy_security_policy":                      resourceNsxtPolicySecurityPolicy(),
+			"nsxt_policy_service":                              resourceNsxtPolicyService(),
+			"nsxt_policy_gateway_policy":                       resourceNsxtPolicyGatewayPolicy(),
+			"nsxt_policy_predefined_gateway_policy":            resourceNsxtPolicyPredefinedGatewayPolicy(),
+			"nsxt_policy_predefined_security_policy":           resourceNsxtPolicyPredefinedSecurityPolicy(),
+			"nsxt_policy_segment":                              resourceNsxtPolicySegment(),
+			"nsxt_policy_vlan_segment":                         resourceNsxtPolicyVlanSegment(),
+			"nsxt_policy_fixed_segment":                        resourceNsxtPolicyFixedSegment(),
+			"nsxt_policy_static_route":                         resourceNsxtPolicyStaticRoute(),
+			"nsxt_policy_gateway_prefix_list":                  resourceNsxtPolicyGatewayPrefixList(),
+			"nsxt_policy_vm_tags":                              resourceNsxtPolicyVMTags(),
+			"nsxt_policy_nat_rule":                             resourceNsxtPolicyNATRule(),
+			"nsxt_policy_ip_block":                             resourceNsxtPolicyIPBlock(),
+			"nsxt_policy_lb_pool":                              resourceNsxtPolicyLBPool(),
+			"nsxt_policy_ip_pool":                              resourceNsxtPolicyIPPool(),
+			"nsxt_policy_ip_pool_block_subnet":                 resourceNsxtPolicyIPPoolBlockSubnet(),
+			"nsxt_policy_ip_pool_static_subnet":                resourceNsxtPolicyIPPoolStaticSubnet(),
+			"nsxt_policy_lb_service":                           resourceNsxtPolicyLBService(),
+			"nsxt_policy_lb_virtual_server":                    resourceNsxtPolicyLBVirtualServer(),
+			"nsxt_policy_ip_address_allocation":                resourceNsxtPolicyIPAddressAllocation(),
+			"nsxt_policy_bgp_neighbor":                         resourceNsxtPolicyBgpNeighbor(),
+			"nsxt_policy_bgp_config":                           resourceNsxtPolicyBgpConfig(),
+			"nsxt_policy_dhcp_relay":                           resourceNsxtPolicyDhcpRelayConfig(),
+			"nsxt_policy_dhcp_server":                          resourceNsxtPolicyDhcpServer(),
+			"nsxt_policy_context_profile":                      resourceNsxtPolicyContextProfile(),
+			"nsxt_policy_context_profile_custom_attribute":     resourceNsxtPolicyContextProfileCustomAttribute(),
+			"nsxt_policy_dhcp_v4_static_binding":               resourceNsxtPolicyDhcpV4StaticBinding(),
+			"nsxt_policy_dhcp_v6_static_binding":               resourceNsxtPolicyDhcpV6StaticBinding(),
+			"nsxt_policy_dns_forwarder_zone":                   resourceNsxtPolicyDNSForwarderZone(),
+			"nsxt_policy_gateway_dns_forwarder":                resourceNsxtPolicyGatewayDNSForwarder(),
+			"nsxt_policy_gateway_community_list":               resourceNsxtPolicyGatewayCommunityList(),
+			"nsxt_policy_gateway_route_map":                    resourceNsxtPolicyGatewayRouteMap(),
+			"nsxt_policy_intrusion_service_policy":             resourceNsxtPolicyIntrusionServicePolicy(),
+			"nsxt_policy_static_route_bfd_peer":                resourceNsxtPolicyStaticRouteBfdPeer(),
+			"nsxt_policy_intrusion_service_profile":            resourceNsxtPolicyIntrusionServiceProfile(),
+			"nsxt_policy_evpn_tenant":                          resourceNsxtPolicyEvpnTenant(),
+			"nsxt_policy_evpn_config":                          resourceNsxtPolicyEvpnConfig(),
+			"nsxt_policy_evpn_tunnel_endpoint":                 resourceNsxtPolicyEvpnTunnelEndpoint(),
+			"nsxt_policy_qos_profile":                          resourceNsxtPolicyQosProfile(),
+			"nsxt_policy_ospf_config":                          resourceNsxtPolicyOspfConfig(),
+			"nsxt_policy_ospf_area":                            resourceNsxtPolicyOspfArea(),
+			"nsxt_policy_gateway_redistribution_config":        resourceNsxtPolicyGatewayRedistributionConfig(),
+			"nsxt_policy_ip_discovery_profile":                 resourceNsxtPolicyIPDiscoveryProfile(),
+			"nsxt_policy_ipv6_ndra_profile":                    resourceNsxtPolicyIpv6NdraProfile(),
+			"nsxt_policy_ipfix_l2_collector_profile":           resourceNsxtPolicyIpfixL2CollectorProfile(),
+			"nsxt_policy_ipfix_l2_profile":                     resourceNsxtPolicyIpfixL2Profile(),
+			"nsxt_policy_ipfix_dfw_collector_profile":          resourceNsxtPolicyIpfixDfwCollectorProfile(),
+			"nsxt_policy_ipfix_dfw_profile":                    resourceNsxtPolicyIpfixDfwProfile(),
+			"nsxt_policy_firewall_exclude_list":                resourceNsxtPolicyFirewallExcludeList(),
+			"nsxt_policy_mac_discovery_profile":                resourceNsxtPolicyMacDiscoveryProfile(),
+			"nsxt_policy_segment_security_profile":             resourceNsxtPolicySegmentSecurityProfile(),
+			"nsxt_policy_gateway_qos_profile":                  resourceNsxtPolicyGatewayQosProfile(),
+			"nsxt_policy_lb_tcp_monitor_profile":               resourceNsxtPolicyLBTcpMonitorProfile(),
+			"nsxt_policy_lb_passive_monitor_profile":           resourceNsxtPolicyLBPassiveMonitorProfile(),
+			"nsxt_policy_draft":                                resourceNsxtPolicyDraft(),
+			"nsxt_policy_ipsec_vpn_ike_profile":                resourceNsxtPolicyIPSecVpnIkeProfile(),
+			"nsxt_policy_ipsec_vpn_tunnel_profile":             resourceNsxtPolicyIPSecVpnTunnelProfile(),
+			"nsxt_policy_ipsec_vpn_dpd_profile":                resourceNsxtPolicyIPSecVpnDpdProfile(),
+			"nsxt_policy_ipsec_vpn_service":                    resourceNsxtPolicyIPSecVpnService(),
+			"nsxt_policy_ipsec_vpn_local_endpoint":             resourceNsxtPolicyIPSecVpnLocalEndpoint(),
+			"nsxt_policy_ipsec_vpn_session":                    resourceNsxtPolicyIPSecVpnSession(),
+			"nsxt_policy_l2_vpn_service":                       resourceNsxtPolicyL2VpnService(),
+			"nsxt_policy_l2_vpn_session":                       resourceNsxtPolicyL2VpnSession(),
+			"nsxt_policy_distributed_flood_protection_profile": resourceNsxtPolicyDistributedFloodProtectionProfile(),
+			"nsxt_policy_static_arp":                           resourceNsxtPolicyStaticArp(),
+			"nsxt_policy_gateway_locale_service":               resourceNsxtPolicyGatewayLocaleService(),
+			"nsxt_cluster_api_certificate":                     resourceNsxtClusterAPICertificate(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -457,6 +558,14 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 		RetriesConfiguration: retriesConfig,
 	}
 
+	err := api.InitHttpClient(&cfg)
+	if err != nil {
+		return err
+	}
+	configureHTTPTransport(d, cfg.HTTPClient.Transport.(*http.Transport))
+	cfg.HTTPClient.Transport = newUserAgentRoundTripper(d, newJSONHeaderRoundTripper(newGzipRoundTripper(cfg.HTTPClient.Transport)))
+	cfg.HTTPClient.Timeout = getHTTPTimeout(d)
+
 	nsxClient, err := api.NewAPIClient(&cfg)
 	if err != nil {
 		return err
@@ -572,6 +681,123 @@ func getConnectorTLSConfig(d *schema.ResourceData) (*tls.Config, error) {
 	return &tlsConfig, nil
 }
 
+// Apply connection pooling settings to transport used by NSX clients
+func configureHTTPTransport(d *schema.ResourceData, tr *http.Transport) {
+	maxIdleConns := d.Get("max_idle_connections").(int)
+	idleConnTimeout := d.Get("idle_connection_timeout").(int)
+
+	// All connections are made to single NSX host
+	tr.MaxIdleConns = maxIdleConns
+	tr.MaxIdleConnsPerHost = maxIdleConns
+	tr.IdleConnTimeout = time.Duration(idleConnTimeout) * time.Second
+	// Advertise gzip and decode compressed responses transparently
+	tr.DisableCompression = false
+}
+
+type userAgentRoundTripper struct {
+	transport http.RoundTripper
+	suffix    string
+}
+
+func getHTTPTimeout(d *schema.ResourceData) time.Duration {
+	return time.Duration(d.Get("http_timeout_seconds").(int)) * time.Second
+}
+
+// Wrap transport to append user_agent_suffix to User-Agent header, if configured
+func newUserAgentRoundTripper(d *schema.ResourceData, transport http.RoundTripper) http.RoundTripper {
+	suffix := d.Get("user_agent_suffix").(string)
+	if suffix == "" {
+		return transport
+	}
+
+	return &userAgentRoundTripper{transport: transport, suffix: suffix}
+}
+
+func (rt *userAgentRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTripper should not modify the original request
+	req = req.Clone(req.Context())
+	userAgent := req.Header.Get("User-Agent")
+	if userAgent == "" {
+		req.Header.Set("User-Agent", rt.suffix)
+	} else {
+		req.Header.Set("User-Agent", fmt.Sprintf("%s %s", userAgent, rt.suffix))
+	}
+
+	return rt.transport.RoundTrip(req)
+}
+
+type jsonHeaderRoundTripper struct {
+	transport http.RoundTripper
+}
+
+// Wrap transport to set JSON Accept and Content-Type headers when not set
+// explicitly, since some proxies in front of NSX reject requests without them
+func newJSONHeaderRoundTripper(transport http.RoundTripper) http.RoundTripper {
+	return &jsonHeaderRoundTripper{transport: transport}
+}
+
+func (rt *jsonHeaderRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	setAccept := req.Header.Get("Accept") == ""
+	setContentType := req.Body != nil && req.Body != http.NoBody && req.Header.Get("Content-Type") == ""
+	if !setAccept && !setContentType {
+		return rt.transport.RoundTrip(req)
+	}
+
+	// RoundTripper should not modify the original request
+	req = req.Clone(req.Context())
+	if setAccept {
+		req.Header.Set("Accept", "application/json")
+	}
+	if setContentType {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return rt.transport.RoundTrip(req)
+}
+
+type gzipRoundTripper struct {
+	transport http.RoundTripper
+}
+
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (rc *gzipReadCloser) Close() error {
+	return rc.body.Close()
+}
+
+// Wrap transport to decode gzip-encoded responses that were not decoded by
+// the underlying transport. This happens when load balancers in front of NSX
+// compress responses regardless of request headers.
+func newGzipRoundTripper(transport http.RoundTripper) http.RoundTripper {
+	return &gzipRoundTripper{transport: transport}
+}
+
+func (rt *gzipRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.transport.RoundTrip(req)
+	if err != nil || resp.Uncompressed || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return resp, err
+	}
+	if req.Method == http.MethodHead || resp.ContentLength == 0 || resp.Body == http.NoBody {
+		return resp, nil
+	}
+
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		resp.Body.Close()
+		return nil, fmt.Errorf("Failed to decode gzip-encoded response: %v", err)
+	}
+
+	resp.Body = &gzipReadCloser{Reader: reader, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return resp, nil
+}
+
 func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients) error {
 	host := d.Get("host").(string)
 	username := d.Get("username").(string)
@@ -641,8 +867,12 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 		Proxy:           http.ProxyFromEnvironment,
 		TLSClientConfig: tlsConfig,
 	}
+	configureHTTPTransport(d, tr)
 
-	httpClient := http.Client{Transport: tr}
+	httpClient := http.Client{
+		Transport: newUserAgentRoundTripper(d, newJSONHeaderRoundTripper(newGzipRoundTripper(tr))),
+		Timeout:   getHTTPTimeout(d),
+	}
 	clients.PolicyHTTPClient = &httpClient
 	if securityContextNeeded {
 		clients.PolicySecurityContext = securityCtx
@@ -690,25 +920,47 @@ func applyLicense(c *api.APIClient, licenseKey string) error {
 	if c == nil {
 		return fmt.Errorf("API client not configured")
 	}
+	if c.LicensingApi == nil {
+		return fmt.Errorf("%w: LicensingApi is not available in NSX client", resourceNotSupportedError())
+	}
 
 	license := licensing.License{LicenseKey: licenseKey}
 	_, resp, err := c.LicensingApi.CreateLicense(c.Context, license)
+	err = wrapMPAPIError(resp, err)
+	// Depending on version, NSX reports existing license either with conflict
+	// status or with an error code in 400 response
+	_, hasErrorCode := getMPAPIErrorCode(err)
+	if (errors.Is(err, ErrConflict) || hasErrorCode) && isLicenseApplied(c, licenseKey) {
+		// License was added concurrently, i.e. by another pipeline
+		log.Printf("[INFO] License is already applied on NSX")
+		return nil
+	}
 	if err != nil {
-		return fmt.Errorf("Error during license create: %v", err)
+		return fmt.Errorf("Error during license create: %w", err)
 	}
-	if resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during license create: %v", resp.StatusCode)
 	}
 
 	return nil
 }
 
+func isLicenseApplied(c *api.APIClient, licenseKey string) bool {
+	license, resp, err := c.LicensingApi.GetLicenseByKey(c.Context, licenseKey)
+	if err != nil || resp == nil || resp.StatusCode != http.StatusOK {
+		return false
+	}
+
+	return license.LicenseKey == licenseKey
+}
+
 // license keys are applied on terraform plan and are not removed
 func configureLicenses(d *schema.ResourceData, clients *nsxtClients) error {
-	for _, licKey := range d.Get("license_keys").([]interface{}) {
+	for i, licKey := range d.Get("license_keys").([]interface{}) {
 		err := applyLicense(clients.NsxtClient, licKey.(string))
 		if err != nil {
-			return fmt.Errorf("Error applying license key: %s. %s", licKey, err.Error())
+			// license key itself should not end up in logs
+			return fmt.Errorf("Error applying license key #%d: %s", i, err.Error())
 		}
 	}
 	return nil
@@ -717,17 +969,25 @@ func configureLicenses(d *schema.ResourceData, clients *nsxtClients) error {
 func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 	remoteAuth := d.Get("remote_auth").(bool)
 	toleratePartialSuccess := d.Get("tolerate_partial_success").(bool)
+	readNotFoundRetries := d.Get("read_not_found_retries").(int)
+	namePrefix := d.Get("name_prefix").(string)
+	pollInterval := time.Duration(d.Get("realization_poll_interval_seconds").(int)) * time.Second
 
 	return commonProviderConfig{
 		RemoteAuth:             remoteAuth,
 		ToleratePartialSuccess: toleratePartialSuccess,
+		ReadNotFoundRetries:    readNotFoundRetries,
+		NamePrefix:             namePrefix,
+		PollInterval:           pollInterval,
 	}
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	commonConfig := initCommonConfig(d)
+	cacheTTL := time.Duration(d.Get("cache_ttl_seconds").(int)) * time.Second
 	clients := nsxtClients{
 		CommonConfig: commonConfig,
+		ListCache:    newListCache(cacheTTL),
 	}
 
 	err := configureNsxtClient(d, &clients)
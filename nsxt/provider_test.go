@@ -4,17 +4,29 @@
 package nsxt
 
 import (
+	"compress/gzip"
 	"context"
 	"crypto/tls"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/licensing"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/security"
@@ -41,6 +53,246 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestApplyLicense_noLicensingApi(t *testing.T) {
+	err := applyLicense(&api.APIClient{}, "license_key")
+	if err == nil || !strings.Contains(err.Error(), "LicensingApi") {
+		t.Fatalf("expected error naming LicensingApi, got: %v", err)
+	}
+	if !errors.Is(err, ErrNotSupported) {
+		t.Errorf("expected error to match ErrNotSupported, got: %v", err)
+	}
+}
+
+func TestApplyLicense_alreadyExists(t *testing.T) {
+	licenseKey := "license_key"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/session/create":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/licenses":
+			// License was added concurrently
+			w.WriteHeader(http.StatusConflict)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/licenses/"+licenseKey:
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"license_key": "%s"}`, licenseKey)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+
+	err := applyLicense(client, licenseKey)
+	if err != nil {
+		t.Fatalf("expected existing license to be adopted, got: %v", err)
+	}
+
+	err = applyLicense(client, "another_license_key")
+	if err == nil {
+		t.Fatalf("expected conflict error for license that is not applied")
+	}
+}
+
+func TestApplyLicense_alreadyExistsErrorCode(t *testing.T) {
+	licenseKey := "license_key"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/session/create":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/licenses":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error_code": 100, "error_message": "License already exists", "module_name": "licensing"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/licenses/"+licenseKey:
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"license_key": "%s"}`, licenseKey)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+
+	err := applyLicense(client, licenseKey)
+	if err != nil {
+		t.Fatalf("expected existing license to be adopted, got: %v", err)
+	}
+
+	err = applyLicense(client, "another_license_key")
+	if err == nil || !strings.Contains(err.Error(), "error code 100") {
+		t.Fatalf("expected NSX error for license that is not applied, got: %v", err)
+	}
+}
+
+func TestApplyLicense_created(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/session/create":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/licenses":
+			// License is expected to be passed as request body
+			var license licensing.License
+			err := json.NewDecoder(r.Body).Decode(&license)
+			if err != nil || license.LicenseKey != "license_key" {
+				t.Errorf("Expected license with key license_key in request body, got %v (%v)", license, err)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"license_key": "license_key"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+
+	err := applyLicense(client, "license_key")
+	if err != nil {
+		t.Fatalf("expected 201 on license create to be accepted, got: %v", err)
+	}
+}
+
+func TestIsSuccess(t *testing.T) {
+	for _, code := range []int{http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent} {
+		if !isSuccess(code) {
+			t.Errorf("expected status %d to be successful", code)
+		}
+	}
+	for _, code := range []int{http.StatusContinue, http.StatusMultipleChoices, http.StatusBadRequest, http.StatusInternalServerError} {
+		if isSuccess(code) {
+			t.Errorf("expected status %d not to be successful", code)
+		}
+	}
+}
+
+func TestProvider_licenseKeysRedacted(t *testing.T) {
+	licenseKey := "AAAAA-BBBBB-CCCCC-DDDDD-EEEEE"
+	if !Provider().Schema["license_keys"].Sensitive {
+		t.Fatalf("expected license_keys to be sensitive")
+	}
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"license_keys": []interface{}{licenseKey},
+	})
+	err := configureLicenses(d, &nsxtClients{})
+	if err == nil {
+		t.Fatalf("expected error applying license without client")
+	}
+	if strings.Contains(err.Error(), licenseKey) {
+		t.Fatalf("license key is exposed in error: %v", err)
+	}
+}
+
+func TestJSONHeaderRoundTripper(t *testing.T) {
+	var headers []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient := http.Client{Transport: newJSONHeaderRoundTripper(http.DefaultTransport)}
+
+	getReq, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	postReq, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"id": "test"}`))
+	formReq, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("j_username=admin"))
+	formReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	formReq.Header.Set("Accept", "*/*")
+	for _, req := range []*http.Request{getReq, postReq, formReq} {
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	if headers[0].Get("Accept") != "application/json" || headers[0].Get("Content-Type") != "" {
+		t.Errorf("Unexpected headers for GET request: %v", headers[0])
+	}
+	if headers[1].Get("Accept") != "application/json" || headers[1].Get("Content-Type") != "application/json" {
+		t.Errorf("Unexpected headers for POST request: %v", headers[1])
+	}
+	if headers[2].Get("Accept") != "*/*" || headers[2].Get("Content-Type") != "application/x-www-form-urlencoded" {
+		t.Errorf("Explicit headers are expected to be preserved, got %v", headers[2])
+	}
+	if postReq.Header.Get("Accept") != "" {
+		t.Errorf("Original request is not expected to be modified")
+	}
+}
+
+func TestGzipRoundTripper(t *testing.T) {
+	var encodings []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encodings = append(encodings, r.Header.Get("Accept-Encoding"))
+		// Compress regardless of request headers, like some load balancers do
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		_, err := gz.Write([]byte(`{"result_count": 0}`))
+		if err != nil {
+			t.Error(err)
+		}
+		err = gz.Close()
+		if err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{})
+	tr := &http.Transport{DisableCompression: true}
+	configureHTTPTransport(d, tr)
+	httpClient := http.Client{Transport: newJSONHeaderRoundTripper(newGzipRoundTripper(tr))}
+
+	defaultReq, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	identityReq, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	identityReq.Header.Set("Accept-Encoding", "identity")
+	for _, req := range []*http.Request{defaultReq, identityReq} {
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != `{"result_count": 0}` {
+			t.Errorf("Expected decoded response for Accept-Encoding %q, got %q", req.Header.Get("Accept-Encoding"), body)
+		}
+		if resp.Header.Get("Content-Encoding") != "" {
+			t.Errorf("Content-Encoding is not expected on decoded response, got %s", resp.Header.Get("Content-Encoding"))
+		}
+	}
+
+	if encodings[0] != "gzip" {
+		t.Errorf("Expected gzip to be advertised by default, got %q", encodings[0])
+	}
+
+	// Response is expected to be parsed by the manager client
+	cfg := api.Configuration{
+		BasePath:   "/api/v1",
+		Host:       server.Listener.Addr().String(),
+		Scheme:     "http",
+		UserAgent:  "terraform-provider-nsxt/1.0",
+		HTTPClient: &httpClient,
+	}
+	nsxClient, err := api.NewAPIClient(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodes, _, err := nsxClient.FabricApi.ListNodes(nsxClient.Context, nil)
+	if err != nil {
+		t.Fatalf("Failed to parse gzip-encoded response: %v", err)
+	}
+	if nodes.ResultCount != 0 {
+		t.Errorf("Unexpected result count %d", nodes.ResultCount)
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	var requiredVariables = []string{"NSXT_USERNAME", "NSXT_PASSWORD", "NSXT_MANAGER_HOST", "NSXT_ALLOW_UNVERIFIED_SSL"}
 	for _, element := range requiredVariables {
@@ -160,3 +412,101 @@ func testAccGetPolicyConnector() (*client.RestConnector, error) {
 
 	return connector, nil
 }
+
+// API families that may only be called from functions named after the
+// object they manage, to catch calls wired to the wrong client
+var apiCallFamilies = []struct {
+	call   *regexp.Regexp
+	caller *regexp.Regexp
+}{
+	{regexp.MustCompile(`^LicensingApi\.`), regexp.MustCompile(`(?i)licen`)},
+	// Static routes are also removed as children of deleted logical router
+	{regexp.MustCompile(`\.\w*StaticRoutes?(Client)?$`), regexp.MustCompile(`StaticRoute|^deleteLogicalRouterChildren$`)},
+}
+
+func getAPICallName(call *ast.CallExpr) string {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	switch x := sel.X.(type) {
+	case *ast.SelectorExpr:
+		return x.Sel.Name + "." + sel.Sel.Name
+	case *ast.Ident:
+		return x.Name + "." + sel.Sel.Name
+	}
+	return ""
+}
+
+func findMismatchedAPICalls(files []*ast.File) []string {
+	var mismatches []string
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				callName := getAPICallName(call)
+				for _, family := range apiCallFamilies {
+					if family.call.MatchString(callName) && !family.caller.MatchString(funcDecl.Name.Name) {
+						mismatches = append(mismatches, fmt.Sprintf("%s calls %s", funcDecl.Name.Name, callName))
+					}
+				}
+				return true
+			})
+		}
+	}
+	sort.Strings(mismatches)
+	return mismatches
+}
+
+func TestAPICallWiring(t *testing.T) {
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, file)
+	}
+
+	if mismatches := findMismatchedAPICalls(files); len(mismatches) > 0 {
+		t.Errorf("API calls from unrelated functions: %v", mismatches)
+	}
+
+	// Make sure the check detects license functions calling routing API and vice versa
+	src := `package nsxt
+func resourceNsxtLicenseCreate(c *api.APIClient) {
+	c.LogicalRoutingAndServicesApi.AddStaticRoute(c.Context, "id", route)
+	c.LicensingApi.CreateLicense(c.Context, license)
+}
+func resourceNsxtStaticRouteCreate(c *api.APIClient) {
+	c.LicensingApi.CreateLicense(c.Context, license)
+	tier_0s.NewDefaultStaticRoutesClient(connector)
+}`
+	file, err := parser.ParseFile(fset, "wiring.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"resourceNsxtLicenseCreate calls LogicalRoutingAndServicesApi.AddStaticRoute",
+		"resourceNsxtStaticRouteCreate calls LicensingApi.CreateLicense",
+	}
+	mismatches := findMismatchedAPICalls([]*ast.File{file})
+	if strings.Join(mismatches, ";") != strings.Join(expected, ";") {
+		t.Errorf("Expected mismatches %v, got %v", expected, mismatches)
+	}
+}
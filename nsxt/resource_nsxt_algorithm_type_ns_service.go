@@ -103,7 +103,7 @@ func resourceNsxtAlgorithmTypeNsServiceCreate(d *schema.ResourceData, m interfac
 		return fmt.Errorf("Error during NsService create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
 	}
 	d.SetId(nsService.Id)
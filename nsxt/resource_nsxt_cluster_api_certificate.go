@@ -0,0 +1,130 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const clusterAPICertificateURI = "/v1/cluster/api-certificate"
+
+// Cluster certificate API is not modeled in MP SDK, hence calls are
+// submitted via batch API
+type clusterCertificateID struct {
+	CertificateID string `json:"certificate_id,omitempty"`
+}
+
+func resourceNsxtClusterAPICertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtClusterAPICertificateCreate,
+		Read:   resourceNsxtClusterAPICertificateRead,
+		Update: resourceNsxtClusterAPICertificateUpdate,
+		Delete: resourceNsxtClusterAPICertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_id": {
+				Type:        schema.TypeString,
+				Description: "Id of the certificate to be used as cluster API certificate",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func setClusterAPICertificate(m interface{}, action string, certificateID string) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	uri := fmt.Sprintf("%s?action=%s&certificate_id=%s", clusterAPICertificateURI, action, url.QueryEscape(certificateID))
+	return callMPAPIWithBatch(nsxClient, http.MethodPost, uri, nil, nil)
+}
+
+func resourceNsxtClusterAPICertificateCreate(d *schema.ResourceData, m interface{}) error {
+	certificateID := d.Get("certificate_id").(string)
+
+	log.Printf("[INFO] Applying certificate %s as cluster API certificate", certificateID)
+	err := setClusterAPICertificate(m, "set_cluster_certificate", certificateID)
+	if err != nil {
+		return fmt.Errorf("Error during ClusterAPICertificate create: %v", err)
+	}
+
+	d.SetId(certificateID)
+
+	return resourceNsxtClusterAPICertificateRead(d, m)
+}
+
+func resourceNsxtClusterAPICertificateRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	var obj clusterCertificateID
+	err := callMPAPIWithBatch(nsxClient, http.MethodGet, clusterAPICertificateURI, nil, &obj)
+	if errors.Is(err, ErrNotFound) {
+		log.Printf("[DEBUG] ClusterAPICertificate %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during ClusterAPICertificate read: %v", err)
+	}
+
+	if obj.CertificateID == "" {
+		log.Printf("[DEBUG] Cluster API certificate is not configured")
+		d.SetId("")
+		return nil
+	}
+
+	// Certificate might have been replaced outside of terraform, in which
+	// case the difference is detected on next plan
+	d.Set("certificate_id", obj.CertificateID)
+
+	return nil
+}
+
+func resourceNsxtClusterAPICertificateUpdate(d *schema.ResourceData, m interface{}) error {
+	certificateID := d.Get("certificate_id").(string)
+
+	log.Printf("[INFO] Applying certificate %s as cluster API certificate", certificateID)
+	err := setClusterAPICertificate(m, "set_cluster_certificate", certificateID)
+	if err != nil {
+		return fmt.Errorf("Error during ClusterAPICertificate update: %v", err)
+	}
+
+	d.SetId(certificateID)
+
+	return resourceNsxtClusterAPICertificateRead(d, m)
+}
+
+func resourceNsxtClusterAPICertificateDelete(d *schema.ResourceData, m interface{}) error {
+	certificateID := d.Get("certificate_id").(string)
+
+	log.Printf("[INFO] Clearing cluster API certificate %s", certificateID)
+	err := setClusterAPICertificate(m, "clear_cluster_certificate", certificateID)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during ClusterAPICertificate delete: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vmware-nsxt/apiservice"
+)
+
+func TestAccResourceNsxtClusterAPICertificate_basic(t *testing.T) {
+	testResourceName := "nsxt_cluster_api_certificate.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtClusterAPICertificateTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtClusterAPICertificateApplied(testResourceName),
+					resource.TestCheckResourceAttrPair(testResourceName, "certificate_id", "data.nsxt_certificate.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtClusterAPICertificate_importBasic(t *testing.T) {
+	testResourceName := "nsxt_cluster_api_certificate.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtClusterAPICertificateTemplate(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtClusterAPICertificateApplied(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("NSX cluster API certificate resource %s not found in resources", resourceName)
+		}
+
+		var obj clusterCertificateID
+		err := callMPAPIWithBatch(nsxClient, http.MethodGet, clusterAPICertificateURI, nil, &obj)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving cluster API certificate: %v", err)
+		}
+
+		if obj.CertificateID != rs.Primary.Attributes["certificate_id"] {
+			return fmt.Errorf("Cluster API certificate is %s, expected %s", obj.CertificateID, rs.Primary.Attributes["certificate_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtClusterAPICertificateTemplate() string {
+	return fmt.Sprintf(`
+data "nsxt_certificate" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_cluster_api_certificate" "test" {
+  certificate_id = data.nsxt_certificate.test.id
+}`, getTestCertificateName(false))
+}
+
+// Certificate replaced outside of terraform is expected to be detected on read
+func TestResourceNsxtClusterAPICertificate_read(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/batch" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var batch apiservice.BatchRequest
+		err := json.NewDecoder(r.Body).Decode(&batch)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		request := batch.Requests[0]
+		received = append(received, fmt.Sprintf("%s %s", request.Method, request.Uri))
+
+		item := `{"code": 200}`
+		if request.Method == http.MethodGet {
+			item = `{"code": 200, "body": {"certificate_id": "cert2"}}`
+		}
+		fmt.Fprintf(w, `{"has_errors": false, "results": [%s]}`, item)
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+	m := nsxtClients{NsxtClient: client}
+
+	d := schema.TestResourceDataRaw(t, resourceNsxtClusterAPICertificate().Schema, map[string]interface{}{
+		"certificate_id": "cert1",
+	})
+	err := resourceNsxtClusterAPICertificateCreate(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"POST /v1/cluster/api-certificate?action=set_cluster_certificate&certificate_id=cert1",
+		"GET /v1/cluster/api-certificate",
+	}
+	if len(received) != len(expected) || received[0] != expected[0] || received[1] != expected[1] {
+		t.Errorf("Expected requests %v, got %v", expected, received)
+	}
+	if certificateID := d.Get("certificate_id").(string); certificateID != "cert2" {
+		t.Errorf("Expected applied certificate cert2 to be detected, got %s", certificateID)
+	}
+}
@@ -72,7 +72,7 @@ func resourceNsxtDhcpRelayProfileCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error during DhcpRelayProfile create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during DhcpRelayProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(dhcpRelayProfile.Id)
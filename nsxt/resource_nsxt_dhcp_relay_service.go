@@ -68,7 +68,7 @@ func resourceNsxtDhcpRelayServiceCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error during DhcpRelayService create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during DhcpRelayService create: %v", resp.StatusCode)
 	}
 	d.SetId(dhcpRelayService.Id)
@@ -117,7 +117,7 @@ func resourceNsxtDhcpServerIPPoolCreate(d *schema.ResourceData, m interface{}) e
 	}
 
 	createdPool, resp, err := nsxClient.ServicesApi.CreateDhcpIpPool(nsxClient.Context, serverID, pool)
-	if resp != nil && resp.StatusCode != http.StatusCreated {
+	if resp != nil && !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during DhcpIPPool create: %v", resp.StatusCode)
 	}
 	if err != nil {
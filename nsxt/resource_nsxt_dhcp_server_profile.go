@@ -79,7 +79,7 @@ func resourceNsxtDhcpServerProfileCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error during DhcpProfile create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during DhcpProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(dhcpProfile.Id)
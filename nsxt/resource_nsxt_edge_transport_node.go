@@ -0,0 +1,625 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+var edgeTransportNodeFormFactorValues = []string{"SMALL", "MEDIUM", "LARGE", "XLARGE"}
+
+const edgeNodeResourceType = "EdgeNode"
+const edgeNodeVMPlacementType = "VsphereDeploymentConfig"
+
+// Create waits for edge VM deployment and configuration to complete
+const edgeTransportNodeCreateTimeout = 30 * time.Minute
+
+// MP SDK lacks node deployment info of transport node, hence the payload
+// is modelled here and sent via batch API
+type edgeNodeVMDeploymentConfig struct {
+	PlacementType           string             `json:"placement_type"`
+	ComputeID               string             `json:"compute_id"`
+	DataNetworkIds          []string           `json:"data_network_ids"`
+	DefaultGatewayAddresses []string           `json:"default_gateway_addresses,omitempty"`
+	HostID                  string             `json:"host_id,omitempty"`
+	ManagementNetworkID     string             `json:"management_network_id"`
+	ManagementPortSubnets   []manager.IpSubnet `json:"management_port_subnets,omitempty"`
+	StorageID               string             `json:"storage_id"`
+	VcID                    string             `json:"vc_id"`
+}
+
+type edgeNodeUserSettings struct {
+	CliPassword  string `json:"cli_password,omitempty"`
+	CliUsername  string `json:"cli_username,omitempty"`
+	RootPassword string `json:"root_password,omitempty"`
+}
+
+type edgeNodeDeploymentConfig struct {
+	FormFactor         string                      `json:"form_factor,omitempty"`
+	NodeUserSettings   *edgeNodeUserSettings       `json:"node_user_settings,omitempty"`
+	VMDeploymentConfig *edgeNodeVMDeploymentConfig `json:"vm_deployment_config"`
+}
+
+type edgeNodeSettings struct {
+	AllowSSHRootLogin bool     `json:"allow_ssh_root_login"`
+	DNSServers        []string `json:"dns_servers,omitempty"`
+	EnableSSH         bool     `json:"enable_ssh"`
+	Hostname          string   `json:"hostname"`
+	NtpServers        []string `json:"ntp_servers,omitempty"`
+	SearchDomains     []string `json:"search_domains,omitempty"`
+}
+
+type edgeNode struct {
+	DeploymentConfig *edgeNodeDeploymentConfig `json:"deployment_config,omitempty"`
+	DisplayName      string                    `json:"display_name,omitempty"`
+	ID               string                    `json:"id,omitempty"`
+	NodeSettings     *edgeNodeSettings         `json:"node_settings,omitempty"`
+	ResourceType     string                    `json:"resource_type"`
+}
+
+type edgeTransportNode struct {
+	Revision               int64                           `json:"_revision"`
+	Description            string                          `json:"description,omitempty"`
+	DisplayName            string                          `json:"display_name,omitempty"`
+	ID                     string                          `json:"id,omitempty"`
+	ResourceType           string                          `json:"resource_type,omitempty"`
+	Tags                   []common.Tag                    `json:"tags,omitempty"`
+	HostSwitches           []manager.HostSwitch            `json:"host_switches,omitempty"`
+	NodeDeploymentInfo     *edgeNode                       `json:"node_deployment_info,omitempty"`
+	NodeID                 string                          `json:"node_id,omitempty"`
+	TransportZoneEndpoints []manager.TransportZoneEndPoint `json:"transport_zone_endpoints,omitempty"`
+}
+
+func resourceNsxtEdgeTransportNode() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtEdgeTransportNodeCreate,
+		Read:   resourceNsxtEdgeTransportNodeRead,
+		Update: resourceNsxtEdgeTransportNodeUpdate,
+		Delete: resourceNsxtEdgeTransportNodeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(edgeTransportNodeCreateTimeout),
+			Delete: schema.DefaultTimeout(transportNodeDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision":     getRevisionSchema(),
+			"description":  getMPDescriptionSchema(),
+			"display_name": getMPDisplayNameSchema(),
+			"tag":          getTagsSchema(),
+			"node_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the edge node deployed for this transport node",
+				Computed:    true,
+			},
+			"deployment_config": {
+				Type:        schema.TypeList,
+				Description: "Configuration for deployment of the edge VM",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"form_factor": {
+							Type:         schema.TypeString,
+							Description:  "Form factor of the edge VM",
+							Optional:     true,
+							Default:      "MEDIUM",
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(edgeTransportNodeFormFactorValues, false),
+						},
+						"node_user_settings": {
+							Type:        schema.TypeList,
+							Description: "Credentials of the edge VM users",
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cli_username": {
+										Type:        schema.TypeString,
+										Description: "CLI user name",
+										Optional:    true,
+										Default:     "admin",
+										ForceNew:    true,
+									},
+									"cli_password": {
+										Type:        schema.TypeString,
+										Description: "Password of CLI user",
+										Required:    true,
+										Sensitive:   true,
+										ForceNew:    true,
+									},
+									"root_password": {
+										Type:        schema.TypeString,
+										Description: "Password of root user",
+										Required:    true,
+										Sensitive:   true,
+										ForceNew:    true,
+									},
+								},
+							},
+						},
+						"vm_deployment_config": {
+							Type:        schema.TypeList,
+							Description: "Placement of the edge VM on vSphere",
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"compute_id": {
+										Type:        schema.TypeString,
+										Description: "Cluster or resource pool ID the edge VM is deployed on",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"data_network_ids": {
+										Type:        schema.TypeList,
+										Description: "IDs of networks for the data path interfaces of the edge VM",
+										Required:    true,
+										ForceNew:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"default_gateway_addresses": {
+										Type:        schema.TypeList,
+										Description: "Default gateway addresses for the management interface",
+										Optional:    true,
+										ForceNew:    true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateSingleIP(),
+										},
+									},
+									"host_id": {
+										Type:        schema.TypeString,
+										Description: "Host ID the edge VM is deployed on",
+										Optional:    true,
+										ForceNew:    true,
+									},
+									"management_network_id": {
+										Type:        schema.TypeString,
+										Description: "ID of network for the management interface of the edge VM",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"management_port_subnet": {
+										Type:        schema.TypeList,
+										Description: "Static IP configuration of the management interface. DHCP is used if not specified",
+										Optional:    true,
+										ForceNew:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ip_addresses": {
+													Type:        schema.TypeList,
+													Description: "IP addresses of the management interface",
+													Required:    true,
+													ForceNew:    true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validateSingleIP(),
+													},
+												},
+												"prefix_length": {
+													Type:         schema.TypeInt,
+													Description:  "Prefix length of the management subnet",
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntBetween(1, 128),
+												},
+											},
+										},
+									},
+									"storage_id": {
+										Type:        schema.TypeString,
+										Description: "Datastore ID the edge VM is deployed on",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"vc_id": {
+										Type:        schema.TypeString,
+										Description: "ID of the compute manager the edge VM is deployed by",
+										Required:    true,
+										ForceNew:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"node_settings": {
+				Type:        schema.TypeList,
+				Description: "Settings of the edge node",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_ssh_root_login": {
+							Type:        schema.TypeBool,
+							Description: "Allow root SSH login",
+							Optional:    true,
+							Default:     false,
+						},
+						"dns_servers": {
+							Type:        schema.TypeList,
+							Description: "DNS servers",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateSingleIP(),
+							},
+						},
+						"enable_ssh": {
+							Type:        schema.TypeBool,
+							Description: "Enable SSH",
+							Optional:    true,
+							Default:     false,
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Description: "Host name of the edge VM",
+							Required:    true,
+						},
+						"ntp_servers": {
+							Type:        schema.TypeList,
+							Description: "NTP servers",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"search_domains": {
+							Type:        schema.TypeList,
+							Description: "Search domain names",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"host_switch":             getTransportNodeHostSwitchSchema(),
+			"transport_zone_endpoint": getTransportNodeTransportZoneEndpointSchema(),
+		},
+	}
+}
+
+func getEdgeNodeDeploymentConfigFromSchema(d *schema.ResourceData, withUserSettings bool) *edgeNodeDeploymentConfig {
+	for _, config := range d.Get("deployment_config").([]interface{}) {
+		data := config.(map[string]interface{})
+		deploymentConfig := edgeNodeDeploymentConfig{
+			FormFactor: data["form_factor"].(string),
+		}
+
+		if withUserSettings {
+			for _, settings := range data["node_user_settings"].([]interface{}) {
+				settingsData := settings.(map[string]interface{})
+				deploymentConfig.NodeUserSettings = &edgeNodeUserSettings{
+					CliUsername:  settingsData["cli_username"].(string),
+					CliPassword:  settingsData["cli_password"].(string),
+					RootPassword: settingsData["root_password"].(string),
+				}
+			}
+		}
+
+		for _, vmConfig := range data["vm_deployment_config"].([]interface{}) {
+			vmData := vmConfig.(map[string]interface{})
+			var subnets []manager.IpSubnet
+			for _, subnet := range vmData["management_port_subnet"].([]interface{}) {
+				subnetData := subnet.(map[string]interface{})
+				subnets = append(subnets, manager.IpSubnet{
+					IpAddresses:  interface2StringList(subnetData["ip_addresses"].([]interface{})),
+					PrefixLength: int64(subnetData["prefix_length"].(int)),
+				})
+			}
+			deploymentConfig.VMDeploymentConfig = &edgeNodeVMDeploymentConfig{
+				PlacementType:           edgeNodeVMPlacementType,
+				ComputeID:               vmData["compute_id"].(string),
+				DataNetworkIds:          interface2StringList(vmData["data_network_ids"].([]interface{})),
+				DefaultGatewayAddresses: interface2StringList(vmData["default_gateway_addresses"].([]interface{})),
+				HostID:                  vmData["host_id"].(string),
+				ManagementNetworkID:     vmData["management_network_id"].(string),
+				ManagementPortSubnets:   subnets,
+				StorageID:               vmData["storage_id"].(string),
+				VcID:                    vmData["vc_id"].(string),
+			}
+		}
+
+		return &deploymentConfig
+	}
+
+	return nil
+}
+
+func setEdgeNodeDeploymentConfigInSchema(d *schema.ResourceData, deploymentConfig *edgeNodeDeploymentConfig) error {
+	var configList []map[string]interface{}
+	if deploymentConfig != nil {
+		elem := make(map[string]interface{})
+		elem["form_factor"] = deploymentConfig.FormFactor
+
+		// Passwords are not returned by NSX, hence user settings are
+		// preserved from configuration
+		var cliUsername, cliPassword, rootPassword string
+		if deploymentConfig.NodeUserSettings != nil {
+			cliUsername = deploymentConfig.NodeUserSettings.CliUsername
+		}
+		if cliUsername == "" {
+			cliUsername = d.Get("deployment_config.0.node_user_settings.0.cli_username").(string)
+		}
+		cliPassword = d.Get("deployment_config.0.node_user_settings.0.cli_password").(string)
+		rootPassword = d.Get("deployment_config.0.node_user_settings.0.root_password").(string)
+		userSettings := make(map[string]interface{})
+		userSettings["cli_username"] = cliUsername
+		userSettings["cli_password"] = cliPassword
+		userSettings["root_password"] = rootPassword
+		elem["node_user_settings"] = []map[string]interface{}{userSettings}
+
+		var vmConfigList []map[string]interface{}
+		if deploymentConfig.VMDeploymentConfig != nil {
+			vmConfig := deploymentConfig.VMDeploymentConfig
+			vmElem := make(map[string]interface{})
+			vmElem["compute_id"] = vmConfig.ComputeID
+			vmElem["data_network_ids"] = vmConfig.DataNetworkIds
+			vmElem["default_gateway_addresses"] = vmConfig.DefaultGatewayAddresses
+			vmElem["host_id"] = vmConfig.HostID
+			vmElem["management_network_id"] = vmConfig.ManagementNetworkID
+			vmElem["storage_id"] = vmConfig.StorageID
+			vmElem["vc_id"] = vmConfig.VcID
+			var subnetList []map[string]interface{}
+			for _, subnet := range vmConfig.ManagementPortSubnets {
+				subnetElem := make(map[string]interface{})
+				subnetElem["ip_addresses"] = subnet.IpAddresses
+				subnetElem["prefix_length"] = subnet.PrefixLength
+				subnetList = append(subnetList, subnetElem)
+			}
+			vmElem["management_port_subnet"] = subnetList
+			vmConfigList = append(vmConfigList, vmElem)
+		}
+		elem["vm_deployment_config"] = vmConfigList
+		configList = append(configList, elem)
+	}
+
+	return d.Set("deployment_config", configList)
+}
+
+func getEdgeNodeSettingsFromSchema(d *schema.ResourceData) *edgeNodeSettings {
+	for _, settings := range d.Get("node_settings").([]interface{}) {
+		data := settings.(map[string]interface{})
+		return &edgeNodeSettings{
+			AllowSSHRootLogin: data["allow_ssh_root_login"].(bool),
+			DNSServers:        interface2StringList(data["dns_servers"].([]interface{})),
+			EnableSSH:         data["enable_ssh"].(bool),
+			Hostname:          data["hostname"].(string),
+			NtpServers:        interface2StringList(data["ntp_servers"].([]interface{})),
+			SearchDomains:     interface2StringList(data["search_domains"].([]interface{})),
+		}
+	}
+
+	return nil
+}
+
+func setEdgeNodeSettingsInSchema(d *schema.ResourceData, settings *edgeNodeSettings) error {
+	var settingsList []map[string]interface{}
+	if settings != nil {
+		elem := make(map[string]interface{})
+		elem["allow_ssh_root_login"] = settings.AllowSSHRootLogin
+		elem["dns_servers"] = settings.DNSServers
+		elem["enable_ssh"] = settings.EnableSSH
+		elem["hostname"] = settings.Hostname
+		elem["ntp_servers"] = settings.NtpServers
+		elem["search_domains"] = settings.SearchDomains
+		settingsList = append(settingsList, elem)
+	}
+
+	return d.Set("node_settings", settingsList)
+}
+
+func getEdgeTransportNodeFromSchema(d *schema.ResourceData, isCreate bool) edgeTransportNode {
+	displayName := d.Get("display_name").(string)
+	transportNode := edgeTransportNode{
+		Description:            d.Get("description").(string),
+		DisplayName:            displayName,
+		Tags:                   getTagsFromSchema(d),
+		HostSwitches:           getTransportNodeHostSwitchesFromSchema(d),
+		TransportZoneEndpoints: getTransportNodeTransportZoneEndpointsFromSchema(d),
+		NodeDeploymentInfo: &edgeNode{
+			DeploymentConfig: getEdgeNodeDeploymentConfigFromSchema(d, isCreate),
+			DisplayName:      displayName,
+			NodeSettings:     getEdgeNodeSettingsFromSchema(d),
+			ResourceType:     edgeNodeResourceType,
+		},
+	}
+
+	if !isCreate {
+		transportNode.Revision = int64(d.Get("revision").(int))
+		transportNode.NodeID = d.Get("node_id").(string)
+		transportNode.NodeDeploymentInfo.ID = transportNode.NodeID
+	}
+
+	return transportNode
+}
+
+// Edge VM deployment takes a while, hence creation waits until the
+// transport node is configured successfully
+func resourceNsxtEdgeTransportNodeWaitForDeployment(d *schema.ResourceData, nsxClient *api.APIClient, id string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending", "in_progress"},
+		Target:  []string{"success"},
+		Refresh: func() (interface{}, string, error) {
+			state, resp, err := nsxClient.NetworkTransportApi.GetTransportNodeState(nsxClient.Context, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error while querying deployment state: %v", err)
+			}
+
+			if resp.StatusCode != http.StatusOK {
+				return nil, "", fmt.Errorf("Unexpected return status %d", resp.StatusCode)
+			}
+
+			if state.FailureCode != 0 {
+				return nil, "", fmt.Errorf("Error in edge deployment: %s", state.FailureMessage)
+			}
+
+			log.Printf("[DEBUG] Deployment state: %s", state.State)
+			return state, state.State, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceNsxtEdgeTransportNodeCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	transportNode := getEdgeTransportNodeFromSchema(d, true)
+	err := callMPAPIWithBatch(nsxClient, http.MethodPost, "/v1/transport-nodes", transportNode, &transportNode)
+	if err != nil {
+		return fmt.Errorf("Error during EdgeTransportNode create: %v", err)
+	}
+
+	// Setting the ID before waiting, so that failed deployment is tainted
+	// and cleaned up by terraform
+	d.SetId(transportNode.ID)
+
+	err = resourceNsxtEdgeTransportNodeWaitForDeployment(d, nsxClient, transportNode.ID)
+	if err != nil {
+		return err
+	}
+
+	if defaultName := getDefaultMPDisplayName(d, m, transportNode.ID); defaultName != "" {
+		// Revision changes during deployment, hence the node is retrieved again
+		err = callMPAPIWithBatch(nsxClient, http.MethodGet, fmt.Sprintf("/v1/transport-nodes/%s", transportNode.ID), nil, &transportNode)
+		if err != nil {
+			return fmt.Errorf("Error during EdgeTransportNode %s read: %v", transportNode.ID, err)
+		}
+		transportNode.DisplayName = defaultName
+		if transportNode.NodeDeploymentInfo != nil {
+			transportNode.NodeDeploymentInfo.DisplayName = defaultName
+		}
+		err = callMPAPIWithBatch(nsxClient, http.MethodPut, fmt.Sprintf("/v1/transport-nodes/%s", transportNode.ID), transportNode, nil)
+		if err != nil {
+			return fmt.Errorf("Error during EdgeTransportNode %s display name update: %v", transportNode.ID, err)
+		}
+	}
+
+	return resourceNsxtEdgeTransportNodeRead(d, m)
+}
+
+func resourceNsxtEdgeTransportNodeRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	var transportNode edgeTransportNode
+	err := callMPAPIWithBatch(nsxClient, http.MethodGet, fmt.Sprintf("/v1/transport-nodes/%s", id), nil, &transportNode)
+	if errors.Is(err, ErrNotFound) {
+		log.Printf("[DEBUG] EdgeTransportNode %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during EdgeTransportNode read: %v", err)
+	}
+
+	if transportNode.NodeDeploymentInfo == nil || transportNode.NodeDeploymentInfo.ResourceType != edgeNodeResourceType {
+		return fmt.Errorf("Transport node %s is not an edge node", id)
+	}
+
+	d.Set("revision", transportNode.Revision)
+	d.Set("description", transportNode.Description)
+	setMPDisplayNameInSchema(d, transportNode.DisplayName)
+	setTagsInSchema(d, transportNode.Tags)
+	d.Set("node_id", transportNode.NodeID)
+
+	err = setEdgeNodeDeploymentConfigInSchema(d, transportNode.NodeDeploymentInfo.DeploymentConfig)
+	if err != nil {
+		return fmt.Errorf("Error during EdgeTransportNode deployment config set in schema: %v", err)
+	}
+	err = setEdgeNodeSettingsInSchema(d, transportNode.NodeDeploymentInfo.NodeSettings)
+	if err != nil {
+		return fmt.Errorf("Error during EdgeTransportNode node settings set in schema: %v", err)
+	}
+	err = setTransportNodeHostSwitchesInSchema(d, transportNode.HostSwitches)
+	if err != nil {
+		return fmt.Errorf("Error during EdgeTransportNode host switches set in schema: %v", err)
+	}
+	err = setTransportNodeTransportZoneEndpointsInSchema(d, transportNode.TransportZoneEndpoints)
+	if err != nil {
+		return fmt.Errorf("Error during EdgeTransportNode transport zone endpoints set in schema: %v", err)
+	}
+
+	return nil
+}
+
+func resourceNsxtEdgeTransportNodeUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	transportNode := getEdgeTransportNodeFromSchema(d, false)
+	err := callMPAPIWithBatch(nsxClient, http.MethodPut, fmt.Sprintf("/v1/transport-nodes/%s", id), transportNode, nil)
+	if err != nil {
+		return fmt.Errorf("Error during EdgeTransportNode update: %v", err)
+	}
+
+	return resourceNsxtEdgeTransportNodeRead(d, m)
+}
+
+func resourceNsxtEdgeTransportNodeDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	// Deleting the transport node also undeploys the edge VM
+	resp, err := nsxClient.NetworkTransportApi.DeleteTransportNode(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during EdgeTransportNode delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] EdgeTransportNode %s not found", id)
+		d.SetId("")
+		return nil
+	}
+
+	return waitForTransportNodeDeletion(d, m, nsxClient, id)
+}
@@ -0,0 +1,210 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccNSXEdgeTransportNodePreCheck(t *testing.T) {
+	testAccOnlyLocalManager(t)
+	testAccTestMP(t)
+	testAccPreCheck(t)
+	testAccEnvDefined(t, "NSXT_TEST_COMPUTE_MANAGER")
+	testAccEnvDefined(t, "NSXT_TEST_COMPUTE_COLLECTION")
+	testAccEnvDefined(t, "NSXT_TEST_EDGE_STORAGE_ID")
+	testAccEnvDefined(t, "NSXT_TEST_EDGE_NETWORK_ID")
+}
+
+func TestAccResourceNsxtEdgeTransportNode_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_edge_transport_node.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccNSXEdgeTransportNodePreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXEdgeTransportNodeCheckDestroy(state, updateName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXEdgeTransportNodeTemplate(name, "Acceptance Test", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXEdgeTransportNodeExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttrSet(testResourceName, "node_id"),
+					resource.TestCheckResourceAttr(testResourceName, "deployment_config.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "deployment_config.0.form_factor", "SMALL"),
+					resource.TestCheckResourceAttr(testResourceName, "deployment_config.0.vm_deployment_config.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "deployment_config.0.vm_deployment_config.0.storage_id", getTestEdgeStorageID()),
+					resource.TestCheckResourceAttr(testResourceName, "deployment_config.0.vm_deployment_config.0.management_network_id", getTestEdgeNetworkID()),
+					resource.TestCheckResourceAttr(testResourceName, "deployment_config.0.vm_deployment_config.0.data_network_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "deployment_config.0.vm_deployment_config.0.vc_id", "data.nsxt_compute_manager.test", "id"),
+					resource.TestCheckResourceAttrPair(testResourceName, "deployment_config.0.vm_deployment_config.0.compute_id", "data.nsxt_compute_collection.test", "id"),
+					resource.TestCheckResourceAttr(testResourceName, "node_settings.0.hostname", "tf-test-edge.example.com"),
+					resource.TestCheckResourceAttr(testResourceName, "node_settings.0.enable_ssh", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_zone_endpoint.#", "1"),
+				),
+			},
+			{
+				Config: testAccNSXEdgeTransportNodeTemplate(updateName, "Acceptance Test Update", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXEdgeTransportNodeExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "node_settings.0.enable_ssh", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "deployment_config.0.form_factor", "SMALL"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtEdgeTransportNode_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_edge_transport_node.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccNSXEdgeTransportNodePreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXEdgeTransportNodeCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXEdgeTransportNodeTemplate(name, "Acceptance Test", false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Passwords are not returned by NSX
+				ImportStateVerifyIgnore: []string{"deployment_config.0.node_user_settings"},
+			},
+		},
+	})
+}
+
+func testAccNSXEdgeTransportNodeExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Edge Transport Node resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Edge Transport Node resource ID not set in resources ")
+		}
+
+		transportNode, responseCode, err := nsxClient.NetworkTransportApi.GetTransportNode(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Edge Transport Node ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if Edge Transport Node %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == transportNode.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("Edge Transport Node %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXEdgeTransportNodeCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_edge_transport_node" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		transportNode, responseCode, err := nsxClient.NetworkTransportApi.GetTransportNode(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving Edge Transport Node ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == transportNode.DisplayName {
+			return fmt.Errorf("Edge Transport Node %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXEdgeTransportNodeTemplate(name string, description string, enableSSH bool) string {
+	return fmt.Sprintf(`
+data "nsxt_transport_zone" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_compute_manager" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_compute_collection" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_edge_transport_node" "test" {
+  display_name = "%s"
+  description  = "%s"
+
+  deployment_config {
+    form_factor = "SMALL"
+
+    node_user_settings {
+      cli_password  = "CliPassw0rd!23"
+      root_password = "RootPassw0rd!23"
+    }
+
+    vm_deployment_config {
+      vc_id                 = data.nsxt_compute_manager.test.id
+      compute_id            = data.nsxt_compute_collection.test.id
+      storage_id            = "%s"
+      management_network_id = "%s"
+      data_network_ids      = ["%s"]
+    }
+  }
+
+  node_settings {
+    hostname   = "tf-test-edge.example.com"
+    enable_ssh = %t
+  }
+
+  host_switch {
+    host_switch_name = data.nsxt_transport_zone.test.host_switch_name
+
+    pnic {
+      device_name = "fp-eth0"
+      uplink_name = "uplink-1"
+    }
+  }
+
+  transport_zone_endpoint {
+    transport_zone_id = data.nsxt_transport_zone.test.id
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, getOverlayTransportZoneName(), getTestComputeManagerName(), getTestComputeCollectionName(), name, description, getTestEdgeStorageID(), getTestEdgeNetworkID(), getTestEdgeNetworkID(), enableSSH)
+}
@@ -79,7 +79,7 @@ func resourceNsxtEtherTypeNsServiceCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error during NsService create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
 	}
 	d.SetId(nsService.Id)
@@ -286,7 +286,7 @@ func resourceNsxtFirewallSectionCreate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("Error during FirewallSection create with rules: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during FirewallSection create with rules: %v", resp.StatusCode)
 	}
 
@@ -94,7 +94,7 @@ func resourceNsxtIcmpTypeNsServiceCreate(d *schema.ResourceData, m interface{})
 	}
 
 	nsService, resp, err := nsxClient.GroupingObjectsApi.CreateIcmpTypeNSService(nsxClient.Context, nsService)
-	if resp != nil && resp.StatusCode != http.StatusCreated {
+	if resp != nil && !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
 	}
 	if err != nil {
@@ -72,7 +72,7 @@ func resourceNsxtIgmpTypeNsServiceCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error during NsService create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
 	}
 	d.SetId(nsService.Id)
@@ -69,7 +69,7 @@ func resourceNsxtIPBlockCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during IpBlock create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during IpBlock create: %v", resp.StatusCode)
 	}
 	d.SetId(ipBlock.Id)
@@ -104,7 +104,7 @@ func resourceNsxtIPBlockSubnetCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error during IpBlockSubnet create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during IpBlockSubnet create: %v", resp.StatusCode)
 	}
 	d.SetId(ipBlockSubnet.Id)
@@ -96,7 +96,7 @@ func resourceNsxtIPDiscoverySwitchingProfileCreate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error during IPDiscoverySwitchingProfile create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during IPDiscoverySwitchingProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(switchingProfile.Id)
@@ -172,7 +172,7 @@ func resourceNsxtIPPoolCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during IpPool create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during IpPool create: %v", resp.StatusCode)
 	}
 	d.SetId(ipPool.Id)
@@ -57,7 +57,7 @@ func resourceNsxtIPPoolAllocationIPAddressCreate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error during IPPoolAllocationIPAddress create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during IPPoolAllocationIPAddress create: %v", resp.StatusCode)
 	}
 	d.SetId(allocationIPAddress.AllocationId)
@@ -72,7 +72,7 @@ func resourceNsxtIPSetCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during IpSet create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during IpSet create: %v", resp.StatusCode)
 	}
 	d.SetId(ipSet.Id)
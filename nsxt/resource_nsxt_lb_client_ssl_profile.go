@@ -92,7 +92,7 @@ func resourceNsxtLbClientSslProfileCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error during LbClientSslProfile create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbClientSslProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbClientSslProfile.Id)
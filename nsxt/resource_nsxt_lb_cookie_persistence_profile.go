@@ -203,7 +203,7 @@ func resourceNsxtLbCookiePersistenceProfileCreate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error during LbCookiePersistenceProfile create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbCookiePersistenceProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbCookiePersistenceProfile.Id)
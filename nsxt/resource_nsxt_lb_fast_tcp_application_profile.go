@@ -88,7 +88,7 @@ func resourceNsxtLbFastTCPApplicationProfileCreate(d *schema.ResourceData, m int
 		return fmt.Errorf("Error during LbFastTcpProfile create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbFastTcpProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbFastTCPProfile.Id)
@@ -129,7 +129,7 @@ func resourceNsxtLbHTTPApplicationProfileCreate(d *schema.ResourceData, m interf
 		return fmt.Errorf("Error during LbHTTPApplicationProfile create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbHTTPApplicationProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbHTTPApplicationProfile.Id)
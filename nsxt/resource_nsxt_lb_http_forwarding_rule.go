@@ -423,7 +423,7 @@ func resourceNsxtLbHTTPForwardingRuleCreate(d *schema.ResourceData, m interface{
 		return fmt.Errorf("Error during LoadBalancerRule create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LoadBalancerRule create: %v", resp.StatusCode)
 	}
 	d.SetId(lbRule.Id)
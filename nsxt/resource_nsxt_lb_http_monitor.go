@@ -97,7 +97,7 @@ func resourceNsxtLbHTTPMonitorCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error during LbHttpMonitor create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbHttpMonitor create: %v", resp.StatusCode)
 	}
 	d.SetId(lbHTTPMonitor.Id)
@@ -340,7 +340,7 @@ func resourceNsxtLbHTTPResponseRewriteRuleCreate(d *schema.ResourceData, m inter
 		return fmt.Errorf("Error during LoadBalancerRule create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LoadBalancerRule create: %v", resp.StatusCode)
 	}
 	d.SetId(lbRule.Id)
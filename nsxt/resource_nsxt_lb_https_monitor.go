@@ -132,7 +132,7 @@ func resourceNsxtLbHTTPSMonitorCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error during LbHttpsMonitor create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbHttpsMonitor create: %v", resp.StatusCode)
 	}
 	d.SetId(lbHTTPSMonitor.Id)
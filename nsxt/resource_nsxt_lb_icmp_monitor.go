@@ -81,7 +81,7 @@ func resourceNsxtLbIcmpMonitorCreate(d *schema.ResourceData, m interface{}) erro
 	}
 
 	lbIcmpMonitor, resp, err := nsxClient.ServicesApi.CreateLoadBalancerIcmpMonitor(nsxClient.Context, lbIcmpMonitor)
-	if resp != nil && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if resp != nil && !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbMonitor create: %v", resp.StatusCode)
 	}
 	if err != nil {
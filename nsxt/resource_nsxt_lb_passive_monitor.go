@@ -77,7 +77,7 @@ func resourceNsxtLbPassiveMonitorCreate(d *schema.ResourceData, m interface{}) e
 		return fmt.Errorf("Error during LbMonitor create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbMonitor create: %v", resp.StatusCode)
 	}
 	d.SetId(lbPassiveMonitor.Id)
@@ -388,7 +388,7 @@ func resourceNsxtLbPoolCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during LbPool create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbPool create: %v", resp.StatusCode)
 	}
 	d.SetId(lbPool.Id)
@@ -76,7 +76,7 @@ func resourceNsxtLbServerSslProfileCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error during LbServerSslProfile create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbServerSslProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbServerSslProfile.Id)
@@ -112,7 +112,7 @@ func resourceNsxtLbServiceCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during LbService create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbService create: %v", resp.StatusCode)
 	}
 	d.SetId(lbService.Id)
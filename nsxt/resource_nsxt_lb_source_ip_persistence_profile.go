@@ -96,7 +96,7 @@ func resourceNsxtLbSourceIPPersistenceProfileCreate(d *schema.ResourceData, m in
 		return fmt.Errorf("Error during LbSourceIPPersistenceProfile create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbSourceIPPersistenceProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbSourceIPPersistenceProfile.Id)
@@ -61,7 +61,7 @@ func resourceNsxtLbTCPMonitorCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error during LbMonitor create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbMonitor create: %v", resp.StatusCode)
 	}
 	d.SetId(lbTCPMonitor.Id)
@@ -151,7 +151,7 @@ func resourceNsxtLbUDPVirtualServerCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error during LbVirtualServer create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LbVirtualServer create: %v", resp.StatusCode)
 	}
 	d.SetId(lbVirtualServer.Id)
@@ -63,7 +63,7 @@ func resourceNsxtLogicalDhcpPortCreate(d *schema.ResourceData, m interface{}) er
 	name := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	lsID := d.Get("logical_switch_id").(string)
-	adminState := d.Get("admin_state").(string)
+	adminState := getAdminStateFromSchema(d)
 	tagList := getTagsFromSchema(d)
 	dhcpServerID := d.Get("dhcp_server_id").(string)
 	attachment := manager.LogicalPortAttachment{
@@ -84,7 +84,7 @@ func resourceNsxtLogicalDhcpPortCreate(d *schema.ResourceData, m interface{}) er
 	if err != nil {
 		return fmt.Errorf("Error while creating logical DHCP port %s: %v", name, err)
 	}
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during Logical DHCP port create: %v", resp.StatusCode)
 	}
 
@@ -138,7 +138,7 @@ func resourceNsxtLogicalDhcpPortUpdate(d *schema.ResourceData, m interface{}) er
 	id := d.Id()
 	name := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	adminState := d.Get("admin_state").(string)
+	adminState := getAdminStateFromSchema(d)
 	lsID := d.Get("logical_switch_id").(string)
 	tagList := getTagsFromSchema(d)
 	revision := int64(d.Get("revision").(int))
@@ -223,7 +223,7 @@ func resourceNsxtLogicalDhcpServerCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error during LogicalDhcpServer create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LogicalDhcpServer create: %v", resp.StatusCode)
 	}
 	d.SetId(logicalDhcpServer.Id)
@@ -57,7 +57,7 @@ func resourceNsxtLogicalPortCreate(d *schema.ResourceData, m interface{}) error
 	name := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	lsID := d.Get("logical_switch_id").(string)
-	adminState := d.Get("admin_state").(string)
+	adminState := getAdminStateFromSchema(d)
 	profilesList := getSwitchingProfileIdsFromSchema(d)
 	tagList := getTagsFromSchema(d)
 
@@ -74,7 +74,7 @@ func resourceNsxtLogicalPortCreate(d *schema.ResourceData, m interface{}) error
 	if err != nil {
 		return fmt.Errorf("Error while creating logical port %s: %v", lp.DisplayName, err)
 	}
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during Logical port create: %v", resp.StatusCode)
 	}
 
@@ -127,7 +127,7 @@ func resourceNsxtLogicalPortUpdate(d *schema.ResourceData, m interface{}) error
 	id := d.Id()
 	name := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	adminState := d.Get("admin_state").(string)
+	adminState := getAdminStateFromSchema(d)
 	profilesList := getSwitchingProfileIdsFromSchema(d)
 	tagList := getTagsFromSchema(d)
 	revision := int64(d.Get("revision").(int))
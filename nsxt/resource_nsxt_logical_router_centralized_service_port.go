@@ -95,7 +95,7 @@ func resourceNsxtLogicalRouterCentralizedServicePortCreate(d *schema.ResourceDat
 		return fmt.Errorf("Error during LogicalRouterCentralizedServicePort create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LogicalRouterCentralizedServicePort create: %v", resp.StatusCode)
 	}
 	d.SetId(LogicalRouterCentralizedServicePort.Id)
@@ -75,7 +75,7 @@ func resourceNsxtLogicalRouterLinkPortOnTier0Create(d *schema.ResourceData, m in
 		return fmt.Errorf("Error during LogicalRouterLinkPortOnTier0 create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LogicalRouterLinkPortOnTier0 create: %v", resp.StatusCode)
 	}
 	d.SetId(logicalRouterLinkPort.Id)
@@ -39,7 +39,7 @@ func resourceNsxtLogicalSwitch() *schema.Resource {
 		Update: resourceNsxtLogicalSwitchUpdate,
 		Delete: resourceNsxtLogicalSwitchDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtResourceImporterByName("Logical Switch", resourceNsxtLogicalSwitchListForImport),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,7 +108,7 @@ func resourceNsxtLogicalSwitchCreate(d *schema.ResourceData, m interface{}) erro
 	displayName := d.Get("display_name").(string)
 	tags := getTagsFromSchema(d)
 	addressBindings := getAddressBindingsFromSchema(d)
-	adminState := d.Get("admin_state").(string)
+	adminState := getAdminStateFromSchema(d)
 	ipPoolID := d.Get("ip_pool_id").(string)
 	macPoolID := d.Get("mac_pool_id").(string)
 	replicationMode := d.Get("replication_mode").(string)
@@ -138,7 +138,7 @@ func resourceNsxtLogicalSwitchCreate(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Error during LogicalSwitch create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LogicalSwitch create: %v", resp.StatusCode)
 	}
 
@@ -262,7 +262,7 @@ func resourceNsxtLogicalSwitchUpdate(d *schema.ResourceData, m interface{}) erro
 	displayName := d.Get("display_name").(string)
 	tags := getTagsFromSchema(d)
 	addressBindings := getAddressBindingsFromSchema(d)
-	adminState := d.Get("admin_state").(string)
+	adminState := getAdminStateFromSchema(d)
 	ipPoolID := d.Get("ip_pool_id").(string)
 	macPoolID := d.Get("mac_pool_id").(string)
 	replicationMode := d.Get("replication_mode").(string)
@@ -320,3 +320,24 @@ func resourceNsxtLogicalSwitchDelete(d *schema.ResourceData, m interface{}) erro
 	}
 	return nil
 }
+
+func resourceNsxtLogicalSwitchListForImport(nsxClient *api.APIClient) ([]importNameCandidate, error) {
+	var candidates []importNameCandidate
+	lister := func(info *paginationInfo) error {
+		objList, _, err := nsxClient.LogicalSwitchingApi.ListLogicalSwitches(nsxClient.Context, info.LocalVarOptionals)
+		if err != nil {
+			return fmt.Errorf("Error while reading logical switches: %v", err)
+		}
+
+		info.PageCount = int64(len(objList.Results))
+		info.TotalCount = objList.ResultCount
+		info.Cursor = objList.Cursor
+		for _, obj := range objList.Results {
+			candidates = append(candidates, importNameCandidate{ID: obj.Id, DisplayName: obj.DisplayName})
+		}
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	return candidates, err
+}
@@ -219,6 +219,12 @@ func TestAccResourceNsxtLogicalSwitch_importBasic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateId:     importByNamePrefix + switchName,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
@@ -23,11 +24,12 @@ func resourceNsxtLogicalTier0Router() *schema.Resource {
 		Update: resourceNsxtLogicalTier0RouterUpdate,
 		Delete: resourceNsxtLogicalTier0RouterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtResourceImporterByName("Logical Tier0 Router", resourceNsxtLogicalTier0RouterListForImport),
 		},
 
 		Schema: map[string]*schema.Schema{
-			"revision": getRevisionSchema(),
+			"revision":     getRevisionSchema(),
+			"force_delete": getLogicalRouterForceDeleteSchema(),
 			"description": {
 				Type:        schema.TypeString,
 				Description: "Description of this resource",
@@ -96,7 +98,7 @@ func resourceNsxtLogicalTier0RouterCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error during LogicalTier0Router create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned: %d", resp.StatusCode)
 	}
 
@@ -190,6 +192,13 @@ func resourceNsxtLogicalTier0RouterDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error obtaining logical tier0 router id")
 	}
 
+	if d.Get("force_delete").(bool) {
+		err := deleteLogicalRouterChildren(nsxClient, id)
+		if err != nil {
+			return fmt.Errorf("Error during LogicalTier0Router delete: %v", err)
+		}
+	}
+
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteLogicalRouter(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
@@ -202,3 +211,7 @@ func resourceNsxtLogicalTier0RouterDelete(d *schema.ResourceData, m interface{})
 	}
 	return nil
 }
+
+func resourceNsxtLogicalTier0RouterListForImport(nsxClient *api.APIClient) ([]importNameCandidate, error) {
+	return listLogicalRoutersForImport(nsxClient, "TIER0")
+}
@@ -104,9 +104,17 @@ func TestAccResourceNsxtLogicalTier0Router_importBasic(t *testing.T) {
 				Config: testAccNSXLogicalTier0RouterCreateTemplate(name, haMode, edgeClusterName),
 			},
 			{
-				ResourceName:      testResourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete"},
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateId:           importByNamePrefix + name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete"},
 			},
 		},
 	})
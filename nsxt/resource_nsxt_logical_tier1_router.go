@@ -37,11 +37,12 @@ func resourceNsxtLogicalTier1Router() *schema.Resource {
 		Update: resourceNsxtLogicalTier1RouterUpdate,
 		Delete: resourceNsxtLogicalTier1RouterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtResourceImporterByName("Logical Tier1 Router", resourceNsxtLogicalTier1RouterListForImport),
 		},
 
 		Schema: map[string]*schema.Schema{
-			"revision": getRevisionSchema(),
+			"revision":     getRevisionSchema(),
+			"force_delete": getLogicalRouterForceDeleteSchema(),
 			"description": {
 				Type:        schema.TypeString,
 				Description: "Description of this resource",
@@ -200,7 +201,7 @@ func resourceNsxtLogicalTier1RouterCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error during LogicalTier1Router create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned: %d", resp.StatusCode)
 	}
 
@@ -322,6 +323,13 @@ func resourceNsxtLogicalTier1RouterDelete(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error obtaining logical tier1 router id")
 	}
 
+	if d.Get("force_delete").(bool) {
+		err := deleteLogicalRouterChildren(nsxClient, id)
+		if err != nil {
+			return fmt.Errorf("Error during LogicalTier1Router delete: %v", err)
+		}
+	}
+
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteLogicalRouter(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
@@ -334,3 +342,7 @@ func resourceNsxtLogicalTier1RouterDelete(d *schema.ResourceData, m interface{})
 	}
 	return nil
 }
+
+func resourceNsxtLogicalTier1RouterListForImport(nsxClient *api.APIClient) ([]importNameCandidate, error) {
+	return listLogicalRoutersForImport(nsxClient, "TIER1")
+}
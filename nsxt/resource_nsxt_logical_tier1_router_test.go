@@ -79,9 +79,17 @@ func TestAccResourceNsxtLogicalTier1Router_importBasic(t *testing.T) {
 				Config: testAccNSXLogicalTier1RouterCreateTemplate(name, failoverMode, edgeClusterName),
 			},
 			{
-				ResourceName:      testResourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete"},
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateId:           importByNamePrefix + name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete"},
 			},
 		},
 	})
@@ -138,7 +138,7 @@ func resourceNsxtMacManagementSwitchingProfileCreate(d *schema.ResourceData, m i
 		return fmt.Errorf("Error during MacManagementSwitchingProfile create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during MacManagementSwitchingProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(switchingProfile.Id)
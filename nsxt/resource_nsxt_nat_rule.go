@@ -73,14 +73,16 @@ func resourceNsxtNatRule() *schema.Resource {
 				Required:    true,
 			},
 			"match_destination_network": {
-				Type:        schema.TypeString,
-				Description: "IP Address | CIDR",
-				Optional:    true,
+				Type:         schema.TypeString,
+				Description:  "IP Address | CIDR",
+				Optional:     true,
+				ValidateFunc: validateIPOrCidr(),
 			},
 			"match_source_network": {
-				Type:        schema.TypeString,
-				Description: "IP Address | CIDR",
-				Optional:    true,
+				Type:         schema.TypeString,
+				Description:  "IP Address | CIDR",
+				Optional:     true,
+				ValidateFunc: validateIPOrCidr(),
 			},
 			"nat_pass": {
 				Type:        schema.TypeBool,
@@ -96,9 +98,10 @@ func resourceNsxtNatRule() *schema.Resource {
 				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"translated_network": {
-				Type:        schema.TypeString,
-				Description: "IP Address | IP Range | CIDR",
-				Optional:    true,
+				Type:         schema.TypeString,
+				Description:  "IP Address | IP Range | CIDR",
+				Optional:     true,
+				ValidateFunc: validateCidrOrIPOrRange(),
 			},
 			"translated_ports": {
 				Type:        schema.TypeString,
@@ -160,7 +163,7 @@ func resourceNsxtNatRuleCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during NatRule create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during NatRule create: %v", resp.StatusCode)
 	}
 	d.SetId(natRule.Id)
@@ -183,7 +183,7 @@ func resourceNsxtNsGroupCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during NsGroup create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during NsGroup create: %v", resp.StatusCode)
 	}
 	d.SetId(nsGroup.Id)
@@ -95,7 +95,7 @@ func resourceNsxtNsServiceGroupCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error during NsServiceGroup create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during NsServiceGroup create: %v", resp.StatusCode)
 	}
 	d.SetId(nsServiceGroup.Id)
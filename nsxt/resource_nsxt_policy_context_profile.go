@@ -487,8 +487,9 @@ func fillAttributesInSchema(d *schema.ResourceData, policyAttributes []model.Pol
 		}
 		attributes[key] = append(attributes[key], elem)
 	}
-	for key, attributeList := range attributes {
-		d.Set(key, attributeList)
+	// Attributes that are absent on backend need to be cleared in state
+	for key := range attributeKeyMap {
+		d.Set(key, attributes[key])
 	}
 }
 
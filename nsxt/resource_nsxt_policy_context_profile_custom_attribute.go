@@ -0,0 +1,204 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_cont_prof "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/context_profiles"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	cont_prof "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/context_profiles"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// CUSTOM_URL key is not yet modeled in the SDK
+const policyAttributesKeyCustomURL = "CUSTOM_URL"
+
+var customAttributeKeyValues = []string{
+	model.PolicyAttributes_KEY_DOMAIN_NAME,
+	policyAttributesKeyCustomURL,
+}
+
+func resourceNsxtPolicyContextProfileCustomAttribute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyContextProfileCustomAttributeCreate,
+		Read:   resourceNsxtPolicyContextProfileCustomAttributeRead,
+		Update: resourceNsxtPolicyContextProfileCustomAttributeUpdate,
+		Delete: resourceNsxtPolicyContextProfileCustomAttributeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Description:  "Key for custom attribute",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(customAttributeKeyValues, false),
+			},
+			"value": {
+				Type:        schema.TypeSet,
+				Description: "Custom values for the attribute key",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func updateNsxtPolicyContextProfileCustomAttribute(connector *client.RestConnector, isGlobalManager bool, key string, values []string, action string) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	dataType := model.PolicyAttributes_DATATYPE_STRING
+	obj := model.PolicyAttributes{
+		Datatype: &dataType,
+		Key:      &key,
+		Value:    values,
+	}
+
+	if isGlobalManager {
+		gmObj, err := convertModelBindingType(obj, model.PolicyAttributesBindingType(), gm_model.PolicyAttributesBindingType())
+		if err != nil {
+			return err
+		}
+		client := gm_cont_prof.NewDefaultCustomAttributesClient(connector)
+		return client.Create(gmObj.(gm_model.PolicyAttributes), action)
+	}
+
+	client := cont_prof.NewDefaultCustomAttributesClient(connector)
+	return client.Create(obj, action)
+}
+
+func listNsxtPolicyContextProfileCustomAttributeValues(connector *client.RestConnector, isGlobalManager bool, key string) ([]string, error) {
+	var objList model.PolicyContextProfileListResult
+	source := model.PolicyAttributes_ATTRIBUTE_SOURCE_CUSTOM
+	includeMarkForDeleteObjectsParam := false
+	if isGlobalManager {
+		client := gm_cont_prof.NewDefaultAttributesClient(connector)
+		gmObjList, err := client.List(&key, &source, nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		rawObjList, err := convertModelBindingType(gmObjList, gm_model.PolicyContextProfileListResultBindingType(), model.PolicyContextProfileListResultBindingType())
+		if err != nil {
+			return nil, err
+		}
+		objList = rawObjList.(model.PolicyContextProfileListResult)
+	} else {
+		var err error
+		client := cont_prof.NewDefaultAttributesClient(connector)
+		objList, err = client.List(&key, &source, nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var values []string
+	for _, profile := range objList.Results {
+		for _, attribute := range profile.Attributes {
+			if attribute.Key == nil || *attribute.Key != key {
+				continue
+			}
+			if attribute.AttributeSource != nil && *attribute.AttributeSource != source {
+				continue
+			}
+			values = append(values, attribute.Value...)
+		}
+	}
+	return values, nil
+}
+
+func resourceNsxtPolicyContextProfileCustomAttributeCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	key := d.Get("key").(string)
+	values := interface2StringList(d.Get("value").(*schema.Set).List())
+
+	log.Printf("[INFO] Adding custom values %v for context profile attribute %s", values, key)
+	err := updateNsxtPolicyContextProfileCustomAttribute(connector, isPolicyGlobalManager(m), key, values, cont_prof.CustomAttributes_CREATE_ACTION_ADD)
+	if err != nil {
+		return handleCreateError("ContextProfileCustomAttribute", key, err)
+	}
+
+	d.SetId(key)
+
+	return resourceNsxtPolicyContextProfileCustomAttributeRead(d, m)
+}
+
+func resourceNsxtPolicyContextProfileCustomAttributeRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	key := d.Id()
+	if key == "" {
+		return fmt.Errorf("Error obtaining ContextProfileCustomAttribute ID")
+	}
+
+	values, err := listNsxtPolicyContextProfileCustomAttributeValues(connector, isPolicyGlobalManager(m), key)
+	if err != nil {
+		return handleReadError(d, "ContextProfileCustomAttribute", key, err)
+	}
+
+	if len(values) == 0 {
+		log.Printf("[DEBUG] No custom values found for context profile attribute %s", key)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("key", key)
+	return d.Set("value", values)
+}
+
+func resourceNsxtPolicyContextProfileCustomAttributeUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	key := d.Id()
+	if key == "" {
+		return fmt.Errorf("Error obtaining ContextProfileCustomAttribute ID")
+	}
+
+	if d.HasChange("value") {
+		isGlobalManager := isPolicyGlobalManager(m)
+		oldValues, newValues := d.GetChange("value")
+		added := interface2StringList(newValues.(*schema.Set).Difference(oldValues.(*schema.Set)).List())
+		removed := interface2StringList(oldValues.(*schema.Set).Difference(newValues.(*schema.Set)).List())
+
+		log.Printf("[INFO] Updating custom values for context profile attribute %s: adding %v, removing %v", key, added, removed)
+		err := updateNsxtPolicyContextProfileCustomAttribute(connector, isGlobalManager, key, added, cont_prof.CustomAttributes_CREATE_ACTION_ADD)
+		if err != nil {
+			return handleUpdateError("ContextProfileCustomAttribute", key, err)
+		}
+		err = updateNsxtPolicyContextProfileCustomAttribute(connector, isGlobalManager, key, removed, cont_prof.CustomAttributes_CREATE_ACTION_REMOVE)
+		if err != nil {
+			return handleUpdateError("ContextProfileCustomAttribute", key, err)
+		}
+	}
+
+	return resourceNsxtPolicyContextProfileCustomAttributeRead(d, m)
+}
+
+func resourceNsxtPolicyContextProfileCustomAttributeDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	key := d.Id()
+	if key == "" {
+		return fmt.Errorf("Error obtaining ContextProfileCustomAttribute ID")
+	}
+
+	values := interface2StringList(d.Get("value").(*schema.Set).List())
+	err := updateNsxtPolicyContextProfileCustomAttribute(connector, isPolicyGlobalManager(m), key, values, cont_prof.CustomAttributes_CREATE_ACTION_REMOVE)
+	if err != nil {
+		return handleDeleteError("ContextProfileCustomAttribute", key, err)
+	}
+
+	return nil
+}
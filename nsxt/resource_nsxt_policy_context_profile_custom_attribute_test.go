@@ -0,0 +1,195 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	cont_prof "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/context_profiles"
+)
+
+var accTestPolicyContextProfileCustomAttributeValues = []string{"test1.terraform.example.org", "test2.terraform.example.org"}
+
+func TestAccResourceNsxtPolicyContextProfileCustomAttribute_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_context_profile_custom_attribute.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyContextProfileCustomAttributeCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyContextProfileCustomAttributeTemplate(accTestPolicyContextProfileCustomAttributeValues[:1]),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyContextProfileCustomAttributeExists(testResourceName, accTestPolicyContextProfileCustomAttributeValues[:1]),
+					resource.TestCheckResourceAttr(testResourceName, "key", "DOMAIN_NAME"),
+					resource.TestCheckResourceAttr(testResourceName, "value.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyContextProfileCustomAttributeTemplate(accTestPolicyContextProfileCustomAttributeValues),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyContextProfileCustomAttributeExists(testResourceName, accTestPolicyContextProfileCustomAttributeValues),
+					resource.TestCheckResourceAttr(testResourceName, "key", "DOMAIN_NAME"),
+					resource.TestCheckResourceAttr(testResourceName, "value.#", "2"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyContextProfileCustomAttributeTemplate(accTestPolicyContextProfileCustomAttributeValues[1:]),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyContextProfileCustomAttributeExists(testResourceName, accTestPolicyContextProfileCustomAttributeValues[1:]),
+					resource.TestCheckResourceAttr(testResourceName, "value.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyContextProfileCustomAttribute_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_context_profile_custom_attribute.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyContextProfileCustomAttributeCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyContextProfileCustomAttributeTemplate(accTestPolicyContextProfileCustomAttributeValues),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyContextProfileCustomAttributeExists(resourceName string, expected []string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy ContextProfileCustomAttribute resource %s not found in resources", resourceName)
+		}
+
+		values, err := listNsxtPolicyContextProfileCustomAttributeValues(connector, testAccIsGlobalManager(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !containsElements(expected, values) {
+			return fmt.Errorf("Custom values %v not found for attribute %s, found %v", expected, rs.Primary.ID, values)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyContextProfileCustomAttributeCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_context_profile_custom_attribute" {
+			continue
+		}
+
+		values, err := listNsxtPolicyContextProfileCustomAttributeValues(connector, testAccIsGlobalManager(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		for _, value := range accTestPolicyContextProfileCustomAttributeValues {
+			if stringInList(value, values) {
+				return fmt.Errorf("Custom value %s for attribute %s still exists", value, rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyContextProfileCustomAttributeTemplate(values []string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_context_profile_custom_attribute" "test" {
+  key   = "DOMAIN_NAME"
+  value = ["%s"]
+}`, strings.Join(values, "\", \""))
+}
+
+func TestPolicyContextProfileCustomAttributeUpdateAndList(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/policy/api/v1/infra/context-profiles/custom-attributes":
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			var obj map[string]interface{}
+			err = json.Unmarshal(body, &obj)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			received = append(received, fmt.Sprintf("%s %s %v %v", r.Method, r.URL.Query().Get("action"), obj["key"], obj["value"]))
+			w.WriteHeader(http.StatusOK)
+		case "/policy/api/v1/infra/context-profiles/attributes":
+			if r.URL.Query().Get("attribute_source") != "CUSTOM" {
+				t.Errorf("Expected custom attributes to be requested, got %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"result_count": 1, "results": [{"attributes": [
+			  {"key": "DOMAIN_NAME", "attribute_source": "CUSTOM", "datatype": "STRING", "value": ["a.example.org", "b.example.org"]},
+			  {"key": "DOMAIN_NAME", "attribute_source": "SYSTEM", "datatype": "STRING", "value": ["*.vmware.com"]},
+			  {"key": "CUSTOM_URL", "attribute_source": "CUSTOM", "datatype": "STRING", "value": ["example.org/path"]}]}]}`)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	connector := client.NewRestConnector(server.URL, *server.Client())
+
+	err := updateNsxtPolicyContextProfileCustomAttribute(connector, false, "DOMAIN_NAME", []string{"a.example.org"}, cont_prof.CustomAttributes_CREATE_ACTION_ADD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = updateNsxtPolicyContextProfileCustomAttribute(connector, false, "DOMAIN_NAME", []string{"c.example.org"}, cont_prof.CustomAttributes_CREATE_ACTION_REMOVE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// No request is expected for empty value list
+	err = updateNsxtPolicyContextProfileCustomAttribute(connector, false, "DOMAIN_NAME", nil, cont_prof.CustomAttributes_CREATE_ACTION_REMOVE)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"POST add DOMAIN_NAME [a.example.org]",
+		"POST remove DOMAIN_NAME [c.example.org]",
+	}
+	if len(received) != len(expected) || received[0] != expected[0] || received[1] != expected[1] {
+		t.Errorf("Expected requests %v, got %v", expected, received)
+	}
+
+	values, err := listNsxtPolicyContextProfileCustomAttributeValues(connector, false, "DOMAIN_NAME")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 || values[0] != "a.example.org" || values[1] != "b.example.org" {
+		t.Errorf("Expected custom domain names only, got %v", values)
+	}
+}
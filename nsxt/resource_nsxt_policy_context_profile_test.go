@@ -5,9 +5,12 @@ package nsxt
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
@@ -361,3 +364,41 @@ app_id {
   value     = ["SSL"]
 }`
 }
+
+func TestResourceNsxtPolicyContextProfileRead_absentAttributes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/policy/api/v1/infra/context-profiles/profile1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"resource_type": "PolicyContextProfile", "id": "profile1", "display_name": "profile1",
+		  "path": "/infra/context-profiles/profile1", "_revision": 1,
+		  "attributes": [{"key": "APP_ID", "value": ["HTTP"], "datatype": "STRING"}]}`)
+	}))
+	defer server.Close()
+	m := nsxtClients{PolicyHTTPClient: server.Client(), Host: server.URL, PolicyEnforcementPoint: "default"}
+
+	d := schema.TestResourceDataRaw(t, resourceNsxtPolicyContextProfile().Schema, map[string]interface{}{
+		"display_name": "profile1",
+		"app_id":       []interface{}{map[string]interface{}{"value": []interface{}{"HTTP"}}},
+		"domain_name":  []interface{}{map[string]interface{}{"value": []interface{}{"*-myfiles.sharepoint.com"}}},
+	})
+	d.SetId("profile1")
+
+	err := resourceNsxtPolicyContextProfileRead(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count := d.Get("app_id").(*schema.Set).Len(); count != 1 {
+		t.Errorf("Expected app_id to be read back, got %d entries", count)
+	}
+	if count := d.Get("domain_name").(*schema.Set).Len(); count != 0 {
+		t.Errorf("Expected domain_name absent on NSX to be cleared, got %d entries", count)
+	}
+	if count := d.Get("url_category").(*schema.Set).Len(); count != 0 {
+		t.Errorf("Expected url_category to be empty, got %d entries", count)
+	}
+}
@@ -0,0 +1,266 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyDistributedFloodProtectionProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyDistributedFloodProtectionProfileCreate,
+		Read:   resourceNsxtPolicyDistributedFloodProtectionProfileRead,
+		Update: resourceNsxtPolicyDistributedFloodProtectionProfileUpdate,
+		Delete: resourceNsxtPolicyDistributedFloodProtectionProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"icmp_active_flow_limit": {
+				Type:         schema.TypeInt,
+				Description:  "Active ICMP connections limit. If not set, no limit is enforced",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 1000000),
+			},
+			"other_active_conn_limit": {
+				Type:         schema.TypeInt,
+				Description:  "Limit of active connections other than UDP, ICMP and half open TCP. If not set, no limit is enforced",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 1000000),
+			},
+			"tcp_half_open_conn_limit": {
+				Type:         schema.TypeInt,
+				Description:  "Half open TCP connections limit. If not set, no limit is enforced",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 1000000),
+			},
+			"udp_active_flow_limit": {
+				Type:         schema.TypeInt,
+				Description:  "Active UDP connections limit. If not set, no limit is enforced",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 1000000),
+			},
+			"enable_rst_spoofing": {
+				Type:        schema.TypeBool,
+				Description: "Flag to indicate rst spoofing is enabled",
+				Optional:    true,
+				Default:     false,
+			},
+			"enable_syncache": {
+				Type:        schema.TypeBool,
+				Description: "Flag to indicate syncache is enabled",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyDistributedFloodProtectionProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDefaultFloodProtectionProfilesClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewDefaultFloodProtectionProfilesClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getFloodProtectionLimitFromSchema(d *schema.ResourceData, key string) *int64 {
+	limit := int64(d.Get(key).(int))
+	if limit == 0 {
+		return nil
+	}
+	return &limit
+}
+
+func getDistributedFloodProtectionProfileFromSchema(d *schema.ResourceData) model.DistributedFloodProtectionProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	enableRstSpoofing := d.Get("enable_rst_spoofing").(bool)
+	enableSyncache := d.Get("enable_syncache").(bool)
+
+	return model.DistributedFloodProtectionProfile{
+		DisplayName:          &displayName,
+		Description:          &description,
+		Tags:                 tags,
+		IcmpActiveFlowLimit:  getFloodProtectionLimitFromSchema(d, "icmp_active_flow_limit"),
+		OtherActiveConnLimit: getFloodProtectionLimitFromSchema(d, "other_active_conn_limit"),
+		TcpHalfOpenConnLimit: getFloodProtectionLimitFromSchema(d, "tcp_half_open_conn_limit"),
+		UdpActiveFlowLimit:   getFloodProtectionLimitFromSchema(d, "udp_active_flow_limit"),
+		EnableRstSpoofing:    &enableRstSpoofing,
+		EnableSyncache:       &enableSyncache,
+		ResourceType:         model.FloodProtectionProfile_RESOURCE_TYPE_DISTRIBUTEDFLOODPROTECTIONPROFILE,
+	}
+}
+
+func patchNsxtPolicyDistributedFloodProtectionProfile(connector *client.RestConnector, id string, obj model.DistributedFloodProtectionProfile, isGlobalManager bool) error {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	boolFalse := false
+
+	if isGlobalManager {
+		convObj, convErrs := converter.ConvertToVapi(obj, gm_model.DistributedFloodProtectionProfileBindingType())
+		if convErrs != nil {
+			return convErrs[0]
+		}
+		client := gm_infra.NewDefaultFloodProtectionProfilesClient(connector)
+		return client.Patch(id, convObj.(*data.StructValue), &boolFalse)
+	}
+
+	convObj, convErrs := converter.ConvertToVapi(obj, model.DistributedFloodProtectionProfileBindingType())
+	if convErrs != nil {
+		return convErrs[0]
+	}
+	client := infra.NewDefaultFloodProtectionProfilesClient(connector)
+	return client.Patch(id, convObj.(*data.StructValue), &boolFalse)
+}
+
+func resourceNsxtPolicyDistributedFloodProtectionProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDistributedFloodProtectionProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getDistributedFloodProtectionProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating DistributedFloodProtectionProfile with ID %s", id)
+	err = patchNsxtPolicyDistributedFloodProtectionProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleCreateError("DistributedFloodProtectionProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyDistributedFloodProtectionProfileRead(d, m)
+}
+
+func resourceNsxtPolicyDistributedFloodProtectionProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DistributedFloodProtectionProfile ID")
+	}
+
+	var err error
+	var profileObj *data.StructValue
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultFloodProtectionProfilesClient(connector)
+		profileObj, err = client.Get(id)
+	} else {
+		client := infra.NewDefaultFloodProtectionProfilesClient(connector)
+		profileObj, err = client.Get(id)
+	}
+	if err != nil {
+		return handleReadError(d, "DistributedFloodProtectionProfile", id, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	convObj, errs := converter.ConvertToGolang(profileObj, model.DistributedFloodProtectionProfileBindingType())
+	if errs != nil {
+		return errs[0]
+	}
+	obj := convObj.(model.DistributedFloodProtectionProfile)
+
+	if obj.ResourceType != model.FloodProtectionProfile_RESOURCE_TYPE_DISTRIBUTEDFLOODPROTECTIONPROFILE {
+		return handleReadError(d, "DistributedFloodProtectionProfile", id, fmt.Errorf("Unexpected ResourceType %s", obj.ResourceType))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("icmp_active_flow_limit", obj.IcmpActiveFlowLimit)
+	d.Set("other_active_conn_limit", obj.OtherActiveConnLimit)
+	d.Set("tcp_half_open_conn_limit", obj.TcpHalfOpenConnLimit)
+	d.Set("udp_active_flow_limit", obj.UdpActiveFlowLimit)
+	d.Set("enable_rst_spoofing", obj.EnableRstSpoofing)
+	d.Set("enable_syncache", obj.EnableSyncache)
+
+	return nil
+}
+
+func resourceNsxtPolicyDistributedFloodProtectionProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DistributedFloodProtectionProfile ID")
+	}
+
+	obj := getDistributedFloodProtectionProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	log.Printf("[INFO] Updating DistributedFloodProtectionProfile with ID %s", id)
+	err := patchNsxtPolicyDistributedFloodProtectionProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleUpdateError("DistributedFloodProtectionProfile", id, err)
+	}
+
+	return resourceNsxtPolicyDistributedFloodProtectionProfileRead(d, m)
+}
+
+func resourceNsxtPolicyDistributedFloodProtectionProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DistributedFloodProtectionProfile ID")
+	}
+
+	var err error
+	connector := getPolicyConnector(m)
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultFloodProtectionProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	} else {
+		client := infra.NewDefaultFloodProtectionProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	}
+
+	if err != nil {
+		return handleDeleteError("DistributedFloodProtectionProfile", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyDistributedFloodProtectionProfileCreateAttributes = map[string]string{
+	"display_name":             getAccTestResourceName(),
+	"description":              "terraform created",
+	"icmp_active_flow_limit":   "3",
+	"other_active_conn_limit":  "3",
+	"tcp_half_open_conn_limit": "3",
+	"udp_active_flow_limit":    "3",
+	"enable_rst_spoofing":      "true",
+	"enable_syncache":          "true",
+}
+
+var accTestPolicyDistributedFloodProtectionProfileUpdateAttributes = map[string]string{
+	"display_name":             getAccTestResourceName(),
+	"description":              "terraform updated",
+	"icmp_active_flow_limit":   "5",
+	"other_active_conn_limit":  "7",
+	"tcp_half_open_conn_limit": "11",
+	"udp_active_flow_limit":    "13",
+	"enable_rst_spoofing":      "false",
+	"enable_syncache":          "false",
+}
+
+func TestAccResourceNsxtPolicyDistributedFloodProtectionProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_distributed_flood_protection_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyDistributedFloodProtectionProfileCheckDestroy(state, accTestPolicyDistributedFloodProtectionProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDistributedFloodProtectionProfileTemplate(true),
+				Check:  testAccNsxtPolicyDistributedFloodProtectionProfileCheckAttributes(testResourceName, accTestPolicyDistributedFloodProtectionProfileCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyDistributedFloodProtectionProfileTemplate(false),
+				Check:  testAccNsxtPolicyDistributedFloodProtectionProfileCheckAttributes(testResourceName, accTestPolicyDistributedFloodProtectionProfileUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyDistributedFloodProtectionProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyDistributedFloodProtectionProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "icmp_active_flow_limit", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "udp_active_flow_limit", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyDistributedFloodProtectionProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_distributed_flood_protection_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyDistributedFloodProtectionProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDistributedFloodProtectionProfileTemplate(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyDistributedFloodProtectionProfileCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyDistributedFloodProtectionProfileExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyDistributedFloodProtectionProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyDistributedFloodProtectionProfileExists)
+}
+
+func testAccNsxtPolicyDistributedFloodProtectionProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_distributed_flood_protection_profile", resourceNsxtPolicyDistributedFloodProtectionProfileExists)
+}
+
+func testAccNsxtPolicyDistributedFloodProtectionProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyDistributedFloodProtectionProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyDistributedFloodProtectionProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_distributed_flood_protection_profile" "test" {
+  display_name = "%s"
+  description  = "%s"
+
+  icmp_active_flow_limit   = %s
+  other_active_conn_limit  = %s
+  tcp_half_open_conn_limit = %s
+  udp_active_flow_limit    = %s
+  enable_rst_spoofing      = %s
+  enable_syncache          = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["icmp_active_flow_limit"], attrMap["other_active_conn_limit"], attrMap["tcp_half_open_conn_limit"], attrMap["udp_active_flow_limit"], attrMap["enable_rst_spoofing"], attrMap["enable_syncache"])
+}
+
+func testAccNsxtPolicyDistributedFloodProtectionProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_distributed_flood_protection_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyDistributedFloodProtectionProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,478 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyDraft() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyDraftCreate,
+		Read:   resourceNsxtPolicyDraftRead,
+		Update: resourceNsxtPolicyDraftUpdate,
+		Delete: resourceNsxtPolicyDraftDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"ref_draft_path": {
+				Type:         schema.TypeString,
+				Description:  "Path of the draft this draft is created against. If not set, draft is created against current published configuration",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Description: "Lock the draft, so that no other user can modify or publish it",
+				Optional:    true,
+				Default:     false,
+			},
+			"lock_comments": {
+				Type:        schema.TypeString,
+				Description: "Comments for the draft lock or unlock",
+				Optional:    true,
+			},
+			"publish": {
+				Type:        schema.TypeBool,
+				Description: "Publish the draft onto current configuration when this flag is set to true",
+				Optional:    true,
+				Default:     false,
+			},
+			"security_policy": {
+				Type:        schema.TypeList,
+				Description: "Security policies staged in this draft, published together with the draft",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nsx_id": {
+							Type:        schema.TypeString,
+							Description: "NSX ID of the security policy",
+							Required:    true,
+						},
+						"domain": {
+							Type:        schema.TypeString,
+							Description: "The domain of the security policy. If not specified 'default' is used",
+							Optional:    true,
+							Default:     defaultDomain,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the security policy",
+							Required:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description of the security policy",
+							Optional:    true,
+						},
+						"category": {
+							Type:         schema.TypeString,
+							Description:  "Category",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(securityPolicyCategoryValues, false),
+						},
+						"scope": {
+							Type:        schema.TypeSet,
+							Description: "The list of group paths where the rules in this policy will get applied",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validatePolicyPath(),
+							},
+						},
+						"sequence_number": {
+							Type:        schema.TypeInt,
+							Description: "This field is used to resolve conflicts between security policies across domains",
+							Optional:    true,
+							Default:     0,
+						},
+						"stateful": {
+							Type:        schema.TypeBool,
+							Description: "When it is stateful, the state of the network connects are tracked and a stateful packet inspection is performed",
+							Optional:    true,
+							Default:     true,
+						},
+						"rule": getSecurityPolicyAndGatewayRulesSchema(false, false),
+					},
+				},
+			},
+			"is_auto_draft": {
+				Type:        schema.TypeBool,
+				Description: "Whether the draft was created by the system",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyDraftExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewDefaultDraftsClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyDraftFromSchema(d *schema.ResourceData) model.PolicyDraft {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	locked := d.Get("locked").(bool)
+	lockComments := d.Get("lock_comments").(string)
+
+	obj := model.PolicyDraft{
+		DisplayName:  &displayName,
+		Description:  &description,
+		Tags:         tags,
+		Locked:       &locked,
+		LockComments: &lockComments,
+	}
+
+	refDraftPath := d.Get("ref_draft_path").(string)
+	if refDraftPath != "" {
+		obj.RefDraftPath = &refDraftPath
+	}
+
+	return obj
+}
+
+func createPolicyDraftChildSecurityPolicy(policyID string, policy model.SecurityPolicy, shouldDelete bool) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	childPolicy := model.ChildSecurityPolicy{
+		Id:              &policyID,
+		ResourceType:    "ChildSecurityPolicy",
+		SecurityPolicy:  &policy,
+		MarkedForDelete: &shouldDelete,
+	}
+
+	dataValue, errors := converter.ConvertToVapi(childPolicy, model.ChildSecurityPolicyBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+// Rule IDs of staged security policies that were published previously, keyed by domain and policy ID
+func getPolicyDraftPublishedRuleIDs(d *schema.ResourceData) map[string][]string {
+	publishedRuleIDs := make(map[string][]string)
+	oldPublish, _ := d.GetChange("publish")
+	if !oldPublish.(bool) {
+		return publishedRuleIDs
+	}
+
+	oldPolicies, _ := d.GetChange("security_policy")
+	for _, policy := range oldPolicies.([]interface{}) {
+		cfg := policy.(map[string]interface{})
+		key := fmt.Sprintf("%s/%s", cfg["domain"].(string), cfg["nsx_id"].(string))
+		ruleIDs := []string{}
+		for _, rule := range cfg["rule"].([]interface{}) {
+			ruleID := rule.(map[string]interface{})["nsx_id"].(string)
+			if ruleID != "" {
+				ruleIDs = append(ruleIDs, ruleID)
+			}
+		}
+		publishedRuleIDs[key] = ruleIDs
+	}
+
+	return publishedRuleIDs
+}
+
+// getPolicyDraftStagedInfra builds hierarchical payload of staged security policies, grouped by domain.
+// Rules are assigned IDs that are kept in state, so that republishing updates the same rules. Policies
+// and rules that were published previously and are no longer configured are marked for delete.
+// Staged policies with generated rule IDs are returned in order to be stored in state.
+func getPolicyDraftStagedInfra(d *schema.ResourceData) (model.Infra, []interface{}, error) {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	publishedRuleIDs := getPolicyDraftPublishedRuleIDs(d)
+	policyResourceType := "SecurityPolicy"
+	ruleResourceType := "Rule"
+
+	var domains []string
+	domainPolicies := make(map[string][]*data.StructValue)
+	addDomainPolicy := func(domain string, childPolicy *data.StructValue) {
+		if _, ok := domainPolicies[domain]; !ok {
+			domains = append(domains, domain)
+		}
+		domainPolicies[domain] = append(domainPolicies[domain], childPolicy)
+	}
+
+	policies := d.Get("security_policy").([]interface{})
+	for _, policy := range policies {
+		cfg := policy.(map[string]interface{})
+		id := cfg["nsx_id"].(string)
+		domain := cfg["domain"].(string)
+		displayName := cfg["display_name"].(string)
+		description := cfg["description"].(string)
+		category := cfg["category"].(string)
+		sequenceNumber := int64(cfg["sequence_number"].(int))
+		stateful := cfg["stateful"].(bool)
+
+		ruleCfgs := cfg["rule"].([]interface{})
+		for _, ruleCfg := range ruleCfgs {
+			rule := ruleCfg.(map[string]interface{})
+			if rule["nsx_id"].(string) == "" {
+				rule["nsx_id"] = newUUID()
+			}
+		}
+
+		var childRules []*data.StructValue
+		existingRules := make(map[string]bool)
+		for _, rule := range getPolicyRulesFromList(ruleCfgs, true) {
+			existingRules[*rule.Id] = true
+			childRule, err := createPolicyChildRule(*rule.Id, rule, false)
+			if err != nil {
+				return model.Infra{}, nil, err
+			}
+			childRules = append(childRules, childRule)
+		}
+
+		key := fmt.Sprintf("%s/%s", domain, id)
+		for _, oldRuleID := range publishedRuleIDs[key] {
+			if existingRules[oldRuleID] {
+				continue
+			}
+			ruleID := oldRuleID
+			rule := model.Rule{
+				Id:           &ruleID,
+				ResourceType: &ruleResourceType,
+			}
+			log.Printf("[DEBUG] Deleting rule %s of staged policy %s", ruleID, id)
+			childRule, err := createPolicyChildRule(ruleID, rule, true)
+			if err != nil {
+				return model.Infra{}, nil, err
+			}
+			childRules = append(childRules, childRule)
+		}
+		delete(publishedRuleIDs, key)
+
+		obj := model.SecurityPolicy{
+			Id:             &id,
+			ResourceType:   &policyResourceType,
+			DisplayName:    &displayName,
+			Description:    &description,
+			Category:       &category,
+			SequenceNumber: &sequenceNumber,
+			Stateful:       &stateful,
+			Scope:          getPathListFromMap(cfg, "scope"),
+			Children:       childRules,
+		}
+
+		childPolicy, err := createPolicyDraftChildSecurityPolicy(id, obj, false)
+		if err != nil {
+			return model.Infra{}, nil, err
+		}
+		addDomainPolicy(domain, childPolicy)
+	}
+
+	// Policies left are no longer configured
+	oldPolicies, _ := d.GetChange("security_policy")
+	for _, policy := range oldPolicies.([]interface{}) {
+		cfg := policy.(map[string]interface{})
+		id := cfg["nsx_id"].(string)
+		domain := cfg["domain"].(string)
+		if _, ok := publishedRuleIDs[fmt.Sprintf("%s/%s", domain, id)]; !ok {
+			continue
+		}
+
+		obj := model.SecurityPolicy{
+			Id:           &id,
+			ResourceType: &policyResourceType,
+		}
+		log.Printf("[DEBUG] Deleting staged policy %s in domain %s", id, domain)
+		childPolicy, err := createPolicyDraftChildSecurityPolicy(id, obj, true)
+		if err != nil {
+			return model.Infra{}, nil, err
+		}
+		addDomainPolicy(domain, childPolicy)
+	}
+
+	var infraChildren []*data.StructValue
+	targetType := "Domain"
+	for i := range domains {
+		childDomain := model.ChildResourceReference{
+			Id:           &domains[i],
+			ResourceType: "ChildResourceReference",
+			TargetType:   &targetType,
+			Children:     domainPolicies[domains[i]],
+		}
+		dataValue, errors := converter.ConvertToVapi(childDomain, model.ChildResourceReferenceBindingType())
+		if len(errors) > 0 {
+			return model.Infra{}, nil, errors[0]
+		}
+		infraChildren = append(infraChildren, dataValue.(*data.StructValue))
+	}
+
+	infraType := "Infra"
+	return model.Infra{
+		Children:     infraChildren,
+		ResourceType: &infraType,
+	}, policies, nil
+}
+
+func publishNsxtPolicyDraft(d *schema.ResourceData, connector *client.RestConnector, id string) error {
+	log.Printf("[INFO] Publishing Draft with ID %s", id)
+	// Staged security policies are published on top of the draft in a single transaction
+	obj, policies, err := getPolicyDraftStagedInfra(d)
+	if err != nil {
+		return err
+	}
+
+	client := infra.NewDefaultDraftsClient(connector)
+	err = client.Publish(id, obj)
+	if err != nil {
+		return logAPIError(fmt.Sprintf("Failed to publish Draft %s", id), err)
+	}
+
+	// Store generated rule IDs, so that next publish updates the same rules
+	return d.Set("security_policy", policies)
+}
+
+func resourceNsxtPolicyDraftCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDraftExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getPolicyDraftFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating Draft with ID %s", id)
+	client := infra.NewDefaultDraftsClient(connector)
+	err = client.Patch(id, obj)
+	if err != nil {
+		return handleCreateError("Draft", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	if d.Get("publish").(bool) {
+		err = publishNsxtPolicyDraft(d, connector, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNsxtPolicyDraftRead(d, m)
+}
+
+func resourceNsxtPolicyDraftRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Draft ID")
+	}
+
+	client := infra.NewDefaultDraftsClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		// Draft that was abandoned and removed on NSX will be re-created
+		return handleReadError(d, "Draft", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("ref_draft_path", obj.RefDraftPath)
+	d.Set("locked", obj.Locked)
+	d.Set("lock_comments", obj.LockComments)
+	d.Set("is_auto_draft", obj.IsAutoDraft)
+
+	return nil
+}
+
+func resourceNsxtPolicyDraftUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Draft ID")
+	}
+
+	obj := getPolicyDraftFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	log.Printf("[INFO] Updating Draft with ID %s", id)
+	client := infra.NewDefaultDraftsClient(connector)
+	_, err := client.Update(id, obj)
+	if err != nil {
+		return handleUpdateError("Draft", id, err)
+	}
+
+	if d.Get("publish").(bool) && (d.HasChange("publish") || d.HasChange("security_policy")) {
+		err = publishNsxtPolicyDraft(d, connector, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNsxtPolicyDraftRead(d, m)
+}
+
+func resourceNsxtPolicyDraftDelete(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Draft ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultDraftsClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Draft", id, err)
+	}
+
+	return nil
+}
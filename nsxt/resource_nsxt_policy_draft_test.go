@@ -0,0 +1,307 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyDraftCreateAttributes = map[string]string{
+	"display_name":  getAccTestResourceName(),
+	"description":   "terraform created",
+	"locked":        "true",
+	"lock_comments": "locked by terraform",
+}
+
+var accTestPolicyDraftUpdateAttributes = map[string]string{
+	"display_name":  getAccTestResourceName(),
+	"description":   "terraform updated",
+	"locked":        "false",
+	"lock_comments": "unlocked by terraform",
+}
+
+func TestAccResourceNsxtPolicyDraft_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_draft.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyDraftCheckDestroy(state, accTestPolicyDraftUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDraftTemplate(true),
+				Check:  testAccNsxtPolicyDraftCheckAttributes(testResourceName, accTestPolicyDraftCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyDraftTemplate(false),
+				Check:  testAccNsxtPolicyDraftCheckAttributes(testResourceName, accTestPolicyDraftUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyDraftMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyDraftExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "locked", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "is_auto_draft", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyDraft_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_draft.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyDraftCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDraftMinimalistic(),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyDraftCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyDraftExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyDraftExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyDraftExists)
+}
+
+func testAccNsxtPolicyDraftCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_draft", resourceNsxtPolicyDraftExists)
+}
+
+func testAccNsxtPolicyDraftTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyDraftCreateAttributes
+	} else {
+		attrMap = accTestPolicyDraftUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_draft" "test" {
+  display_name  = "%s"
+  description   = "%s"
+  locked        = %s
+  lock_comments = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["locked"], attrMap["lock_comments"])
+}
+
+func testAccNsxtPolicyDraftMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_draft" "test" {
+  display_name = "%s"
+}`, accTestPolicyDraftUpdateAttributes["display_name"])
+}
+
+func TestResourceNsxtPolicyDraft_publish(t *testing.T) {
+	var published map[string]interface{}
+	publishCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if !strings.HasPrefix(r.URL.Path, "/policy/api/v1/infra/drafts/") {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		id := strings.TrimPrefix(r.URL.Path, "/policy/api/v1/infra/drafts/")
+		switch {
+		case r.Method == http.MethodPatch:
+			fmt.Fprint(w, `{}`)
+		case r.Method == http.MethodGet || r.Method == http.MethodPut:
+			fmt.Fprintf(w, `{"resource_type": "PolicyDraft", "id": "%s", "display_name": "draft", "path": "/infra/drafts/%s", "_revision": 0, "is_auto_draft": false}`, id, id)
+		case r.Method == http.MethodPost && r.URL.Query().Get("action") == "publish":
+			publishCount++
+			err := json.NewDecoder(r.Body).Decode(&published)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+	m := nsxtClients{PolicyHTTPClient: server.Client(), Host: server.URL, PolicyEnforcementPoint: "default"}
+
+	rule := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"display_name":       name,
+			"action":             "DROP",
+			"source_groups":      []interface{}{"/infra/domains/default/groups/web"},
+			"destination_groups": []interface{}{"/infra/domains/default/groups/db"},
+		}
+	}
+	policy := func(id string, domain string, rules ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"nsx_id":       id,
+			"domain":       domain,
+			"display_name": id,
+			"category":     "Application",
+			"rule":         rules,
+		}
+	}
+	// Children of hierarchical payload object, keyed by ID
+	getChildren := func(obj map[string]interface{}, childType string) map[string]map[string]interface{} {
+		children := make(map[string]map[string]interface{})
+		list, _ := obj["children"].([]interface{})
+		for _, item := range list {
+			child := item.(map[string]interface{})
+			if childType != "" {
+				child = child[childType].(map[string]interface{})
+			}
+			children[child["id"].(string)] = item.(map[string]interface{})
+		}
+		return children
+	}
+	isMarkedForDelete := func(child map[string]interface{}) bool {
+		markedForDelete, _ := child["marked_for_delete"].(bool)
+		return markedForDelete
+	}
+
+	// Draft that is not published does not stage anything on NSX
+	d := schema.TestResourceDataRaw(t, resourceNsxtPolicyDraft().Schema, map[string]interface{}{
+		"display_name":    "draft",
+		"security_policy": []interface{}{policy("policy1", "default", rule("rule1"))},
+	})
+	err := resourceNsxtPolicyDraftCreate(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if publishCount != 0 {
+		t.Errorf("Expected draft not to be published")
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceNsxtPolicyDraft().Schema, map[string]interface{}{
+		"display_name": "draft",
+		"publish":      true,
+		"security_policy": []interface{}{
+			policy("policy1", "default", rule("rule1"), rule("rule2")),
+			policy("policy2", "domain2", rule("rule1")),
+			policy("policy3", "default", rule("rule1")),
+		},
+	})
+	err = resourceNsxtPolicyDraftCreate(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if publishCount != 1 {
+		t.Fatalf("Expected draft to be published once, got %d", publishCount)
+	}
+	if published["resource_type"] != "Infra" {
+		t.Errorf("Expected hierarchical Infra payload, got %v", published)
+	}
+	domains := getChildren(published, "")
+	if len(domains) != 2 || domains["domain2"] == nil {
+		t.Fatalf("Expected staged policies grouped in 2 domains, got %v", published)
+	}
+	domain := domains["default"]
+	if domain["resource_type"] != "ChildResourceReference" || domain["target_type"] != "Domain" {
+		t.Errorf("Unexpected domain reference %v", domain)
+	}
+	policies := getChildren(domain, "SecurityPolicy")
+	if len(policies) != 2 || policies["policy3"]["resource_type"] != "ChildSecurityPolicy" {
+		t.Fatalf("Expected 2 policies in default domain, got %v", domain["children"])
+	}
+	securityPolicy := policies["policy1"]["SecurityPolicy"].(map[string]interface{})
+	if securityPolicy["category"] != "Application" {
+		t.Errorf("Unexpected staged policy %v", securityPolicy)
+	}
+	rules := getChildren(securityPolicy, "Rule")
+	rule1ID := d.Get("security_policy.0.rule.0.nsx_id").(string)
+	rule2ID := d.Get("security_policy.0.rule.1.nsx_id").(string)
+	if len(rules) != 2 || rules[rule1ID] == nil || rules[rule2ID] == nil {
+		t.Fatalf("Expected generated rule IDs %s and %s to be published and stored in state, got %v", rule1ID, rule2ID, securityPolicy["children"])
+	}
+	if rules[rule1ID]["Rule"].(map[string]interface{})["action"] != "DROP" {
+		t.Errorf("Unexpected staged rule %v", rules[rule1ID])
+	}
+
+	// Republish with policy2 and rule2 removed, and policy4 added. Rule IDs
+	// from state are planned for configured rules that do not specify one.
+	d = resourceNsxtPolicyDraft().Data(d.State())
+	stagedPolicies := d.Get("security_policy").([]interface{})
+	policy1 := stagedPolicies[0].(map[string]interface{})
+	policy1["rule"] = policy1["rule"].([]interface{})[:1]
+	d.Set("security_policy", []interface{}{policy1, stagedPolicies[2], policy("policy4", "default", rule("rule1"))})
+	err = resourceNsxtPolicyDraftUpdate(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if publishCount != 2 {
+		t.Fatalf("Expected draft to be republished, got %d publishes", publishCount)
+	}
+
+	domains = getChildren(published, "")
+	policies = getChildren(domains["default"], "SecurityPolicy")
+	if len(policies) != 3 || policies["policy4"] == nil || isMarkedForDelete(policies["policy1"]) || isMarkedForDelete(policies["policy3"]) {
+		t.Fatalf("Expected policies 1, 3 and 4 in default domain, got %v", domains["default"]["children"])
+	}
+	rules = getChildren(policies["policy1"]["SecurityPolicy"].(map[string]interface{}), "Rule")
+	if len(rules) != 2 || rules[rule1ID] == nil || isMarkedForDelete(rules[rule1ID]) {
+		t.Errorf("Expected rule %s to be republished with same ID, got %v", rule1ID, rules)
+	}
+	if rules[rule2ID] == nil || !isMarkedForDelete(rules[rule2ID]) {
+		t.Errorf("Expected removed rule %s to be marked for delete, got %v", rule2ID, rules)
+	}
+	removed := getChildren(domains["domain2"], "SecurityPolicy")
+	if len(removed) != 1 || !isMarkedForDelete(removed["policy2"]) {
+		t.Errorf("Expected removed policy2 to be marked for delete, got %v", domains["domain2"])
+	}
+}
@@ -0,0 +1,120 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security"
+)
+
+// Exclude list is a singleton object in NSX
+const policyFirewallExcludeListID = "exclude-list"
+
+func resourceNsxtPolicyFirewallExcludeList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallExcludeListCreate,
+		Read:   resourceNsxtPolicyFirewallExcludeListRead,
+		Update: resourceNsxtPolicyFirewallExcludeListUpdate,
+		Delete: resourceNsxtPolicyFirewallExcludeListDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path":     getPathSchema(),
+			"revision": getRevisionSchema(),
+			"members": {
+				Type:        schema.TypeSet,
+				Description: "Policy paths of groups excluded from distributed firewall",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+		},
+	}
+}
+
+func updateNsxtPolicyFirewallExcludeList(connector *client.RestConnector, members []string) error {
+	client := security.NewDefaultExcludeListClient(connector)
+	// Exclude list always exists, current revision is needed for the update
+	obj, err := client.Get()
+	if err != nil {
+		return err
+	}
+
+	if members == nil {
+		members = []string{}
+	}
+	obj.Members = members
+	_, err = client.Update(obj)
+	return err
+}
+
+func resourceNsxtPolicyFirewallExcludeListCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+	members := interface2StringList(d.Get("members").(*schema.Set).List())
+
+	log.Printf("[INFO] Creating Firewall Exclude List with members %v", members)
+	err := updateNsxtPolicyFirewallExcludeList(connector, members)
+	if err != nil {
+		return handleCreateError("Firewall Exclude List", policyFirewallExcludeListID, err)
+	}
+
+	d.SetId(policyFirewallExcludeListID)
+
+	return resourceNsxtPolicyFirewallExcludeListRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallExcludeListRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	client := security.NewDefaultExcludeListClient(connector)
+	obj, err := client.Get()
+	if err != nil {
+		return handleReadError(d, "Firewall Exclude List", d.Id(), err)
+	}
+
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("members", obj.Members)
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallExcludeListUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	members := interface2StringList(d.Get("members").(*schema.Set).List())
+
+	log.Printf("[INFO] Updating Firewall Exclude List with members %v", members)
+	err := updateNsxtPolicyFirewallExcludeList(connector, members)
+	if err != nil {
+		return handleUpdateError("Firewall Exclude List", d.Id(), err)
+	}
+
+	return resourceNsxtPolicyFirewallExcludeListRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallExcludeListDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Exclude list can not be deleted, hence members are cleared
+	log.Printf("[INFO] Clearing Firewall Exclude List")
+	err := updateNsxtPolicyFirewallExcludeList(connector, nil)
+	if err != nil {
+		return handleDeleteError("Firewall Exclude List", d.Id(), err)
+	}
+
+	return nil
+}
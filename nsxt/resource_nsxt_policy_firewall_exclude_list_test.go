@@ -0,0 +1,185 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security"
+)
+
+var accTestPolicyFirewallExcludeListGroupName = getAccTestResourceName()
+
+func TestAccResourceNsxtPolicyFirewallExcludeList_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_firewall_exclude_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallExcludeListCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallExcludeListTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallExcludeListExists("nsxt_policy_group.test1"),
+					resource.TestCheckResourceAttr(testResourceName, "members.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallExcludeListTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallExcludeListExists("nsxt_policy_group.test1"),
+					testAccNsxtPolicyFirewallExcludeListExists("nsxt_policy_group.test2"),
+					resource.TestCheckResourceAttr(testResourceName, "members.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallExcludeList_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_firewall_exclude_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallExcludeListCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallExcludeListTemplate(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallExcludeListExists(groupResourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[groupResourceName]
+		if !ok {
+			return fmt.Errorf("Policy Group resource %s not found in resources", groupResourceName)
+		}
+		groupPath := rs.Primary.Attributes["path"]
+
+		client := security.NewDefaultExcludeListClient(connector)
+		obj, err := client.Get()
+		if err != nil {
+			return err
+		}
+		if !stringInList(groupPath, obj.Members) {
+			return fmt.Errorf("Group %s not found in Firewall Exclude List", groupPath)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyFirewallExcludeListCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_group" {
+			continue
+		}
+
+		client := security.NewDefaultExcludeListClient(connector)
+		obj, err := client.Get()
+		if err != nil {
+			return err
+		}
+		if stringInList(rs.Primary.Attributes["path"], obj.Members) {
+			return fmt.Errorf("Group %s still exists in Firewall Exclude List", rs.Primary.Attributes["path"])
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyFirewallExcludeListTemplate(multipleMembers bool) string {
+	members := "nsxt_policy_group.test1.path"
+	if multipleMembers {
+		members = "nsxt_policy_group.test1.path, nsxt_policy_group.test2.path"
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test1" {
+  display_name = "%s-1"
+}
+
+resource "nsxt_policy_group" "test2" {
+  display_name = "%s-2"
+}
+
+resource "nsxt_policy_firewall_exclude_list" "test" {
+  members = [%s]
+}`, accTestPolicyFirewallExcludeListGroupName, accTestPolicyFirewallExcludeListGroupName, members)
+}
+
+func TestPolicyFirewallExcludeListUpdate(t *testing.T) {
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/policy/api/v1/infra/settings/firewall/security/exclude-list" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"id": "exclude-list", "path": "/infra/settings/firewall/security/exclude-list", "_revision": 3, "members": ["/infra/domains/default/groups/old"]}`)
+		case http.MethodPut:
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			err = json.Unmarshal(body, &received)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, string(body))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+	connector := client.NewRestConnector(server.URL, *server.Client())
+
+	err := updateNsxtPolicyFirewallExcludeList(connector, []string{"/infra/domains/default/groups/new"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprintf("%v", received["members"]) != "[/infra/domains/default/groups/new]" || received["_revision"] != float64(3) {
+		t.Errorf("Expected members to be replaced with current revision, got %v", received)
+	}
+
+	// Clearing the list is expected to send an empty member list rather than omitting it
+	err = updateNsxtPolicyFirewallExcludeList(connector, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	members, ok := received["members"].([]interface{})
+	if !ok || len(members) != 0 {
+		t.Errorf("Expected empty member list, got %v", received)
+	}
+}
@@ -0,0 +1,265 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_tier0s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s"
+	gm_tier1s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_1s"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyGatewayLocaleService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyGatewayLocaleServiceCreate,
+		Read:   resourceNsxtPolicyGatewayLocaleServiceRead,
+		Update: resourceNsxtPolicyGatewayLocaleServiceUpdate,
+		Delete: resourceNsxtPolicyGatewayLocaleServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyGatewayLocaleServiceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"gateway_path": getPolicyPathSchema(true, true, "Policy path for the Tier0 or Tier1 Gateway"),
+			"edge_cluster_path": {
+				Type:         schema.TypeString,
+				Description:  "The path of the edge cluster connected to this gateway",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"preferred_edge_paths": {
+				Type:        schema.TypeList,
+				Description: "Paths of specific edge nodes",
+				Optional:    true,
+				Elem:        getElemPolicyPathSchema(),
+			},
+			"bfd_profile_path": getPolicyPathSchema(false, false, "Policy path of BFD profile for static routes on this gateway"),
+		},
+	}
+}
+
+func policyGatewayLocaleServiceGet(connector *client.RestConnector, gwPath string, id string, isGlobalManager bool) (model.LocaleServices, error) {
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if isGlobalManager {
+		var gmObj gm_model.LocaleServices
+		var err error
+		if isT0 {
+			client := gm_tier0s.NewDefaultLocaleServicesClient(connector)
+			gmObj, err = client.Get(gwID, id)
+		} else {
+			client := gm_tier1s.NewDefaultLocaleServicesClient(connector)
+			gmObj, err = client.Get(gwID, id)
+		}
+		if err != nil {
+			return model.LocaleServices{}, err
+		}
+		lmObj, err := convertModelBindingType(gmObj, gm_model.LocaleServicesBindingType(), model.LocaleServicesBindingType())
+		if err != nil {
+			return model.LocaleServices{}, err
+		}
+		return lmObj.(model.LocaleServices), nil
+	}
+
+	if isT0 {
+		client := tier_0s.NewDefaultLocaleServicesClient(connector)
+		return client.Get(gwID, id)
+	}
+	client := tier_1s.NewDefaultLocaleServicesClient(connector)
+	return client.Get(gwID, id)
+}
+
+func resourceNsxtPolicyGatewayLocaleServiceExists(gwPath string) func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+		_, err := policyGatewayLocaleServiceGet(connector, gwPath, id, isGlobalManager)
+		if err == nil {
+			return true, nil
+		}
+
+		if isNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, logAPIError("Error retrieving Locale Service", err)
+	}
+}
+
+func policyGatewayLocaleServicePatch(d *schema.ResourceData, m interface{}, gwPath string, id string) error {
+	connector := getPolicyConnector(m)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	edgeClusterPath := d.Get("edge_cluster_path").(string)
+	preferredEdgePaths := interfaceListToStringList(d.Get("preferred_edge_paths").([]interface{}))
+	bfdProfilePath := d.Get("bfd_profile_path").(string)
+
+	lsType := "LocaleServices"
+	obj := model.LocaleServices{
+		Id:                 &id,
+		DisplayName:        &displayName,
+		Description:        &description,
+		Tags:               tags,
+		ResourceType:       &lsType,
+		EdgeClusterPath:    &edgeClusterPath,
+		PreferredEdgePaths: preferredEdgePaths,
+	}
+	if bfdProfilePath != "" {
+		obj.BfdProfilePath = &bfdProfilePath
+	}
+
+	doPatch := func() error {
+		if isPolicyGlobalManager(m) {
+			gmObj, err := convertModelBindingType(obj, model.LocaleServicesBindingType(), gm_model.LocaleServicesBindingType())
+			if err != nil {
+				return err
+			}
+			if isT0 {
+				client := gm_tier0s.NewDefaultLocaleServicesClient(connector)
+				return client.Patch(gwID, id, gmObj.(gm_model.LocaleServices))
+			}
+			client := gm_tier1s.NewDefaultLocaleServicesClient(connector)
+			return client.Patch(gwID, id, gmObj.(gm_model.LocaleServices))
+		}
+
+		if isT0 {
+			client := tier_0s.NewDefaultLocaleServicesClient(connector)
+			return client.Patch(gwID, id, obj)
+		}
+		client := tier_1s.NewDefaultLocaleServicesClient(connector)
+		return client.Patch(gwID, id, obj)
+	}
+	// locale service is a child of the gateway, hence concurrent gateway
+	// configuration might require retry from client side
+	return retryUponTransientAPIError(doPatch)
+}
+
+func resourceNsxtPolicyGatewayLocaleServiceCreate(d *schema.ResourceData, m interface{}) error {
+	gwPath := d.Get("gateway_path").(string)
+	_, gwID := parseGatewayPolicyPath(gwPath)
+	if gwID == "" {
+		return fmt.Errorf("gateway_path is not valid")
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyGatewayLocaleServiceExists(gwPath))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Locale Service with ID %s on Gateway %s", id, gwID)
+	err = policyGatewayLocaleServicePatch(d, m, gwPath, id)
+	if err != nil {
+		return handleCreateError("Locale Service", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyGatewayLocaleServiceRead(d, m)
+}
+
+func resourceNsxtPolicyGatewayLocaleServiceRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	gwPath := d.Get("gateway_path").(string)
+	if id == "" || gwPath == "" {
+		return fmt.Errorf("Error obtaining Locale Service ID or Gateway path")
+	}
+
+	obj, err := policyGatewayLocaleServiceGet(connector, gwPath, id, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleReadError(d, "Locale Service", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("edge_cluster_path", obj.EdgeClusterPath)
+	d.Set("preferred_edge_paths", obj.PreferredEdgePaths)
+	d.Set("bfd_profile_path", obj.BfdProfilePath)
+
+	return nil
+}
+
+func resourceNsxtPolicyGatewayLocaleServiceUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	gwPath := d.Get("gateway_path").(string)
+	if id == "" || gwPath == "" {
+		return fmt.Errorf("Error obtaining Locale Service ID or Gateway path")
+	}
+
+	log.Printf("[INFO] Updating Locale Service with ID %s", id)
+	err := policyGatewayLocaleServicePatch(d, m, gwPath, id)
+	if err != nil {
+		return handleUpdateError("Locale Service", id, err)
+	}
+
+	return resourceNsxtPolicyGatewayLocaleServiceRead(d, m)
+}
+
+func resourceNsxtPolicyGatewayLocaleServiceDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	gwPath := d.Get("gateway_path").(string)
+	if id == "" || gwPath == "" {
+		return fmt.Errorf("Error obtaining Locale Service ID or Gateway path")
+	}
+
+	connector := getPolicyConnector(m)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	var err error
+	if isPolicyGlobalManager(m) {
+		if isT0 {
+			client := gm_tier0s.NewDefaultLocaleServicesClient(connector)
+			err = client.Delete(gwID, id)
+		} else {
+			client := gm_tier1s.NewDefaultLocaleServicesClient(connector)
+			err = client.Delete(gwID, id)
+		}
+	} else {
+		if isT0 {
+			client := tier_0s.NewDefaultLocaleServicesClient(connector)
+			err = client.Delete(gwID, id)
+		} else {
+			client := tier_1s.NewDefaultLocaleServicesClient(connector)
+			err = client.Delete(gwID, id)
+		}
+	}
+
+	if err != nil {
+		return handleDeleteError("Locale Service", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyGatewayLocaleServiceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importPath := d.Id()
+	if !strings.Contains(importPath, "/locale-services/") {
+		return nil, fmt.Errorf("Please provide policy path of the Locale Service as an input")
+	}
+
+	d.Set("gateway_path", getGatewayPathFromLocaleServicesPath(importPath))
+	d.SetId(getPolicyIDFromPath(importPath))
+
+	return []*schema.ResourceData{d}, nil
+}
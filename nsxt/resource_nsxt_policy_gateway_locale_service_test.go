@@ -0,0 +1,186 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyGatewayLocaleServiceHelperName = getAccTestResourceName()
+
+func TestAccResourceNsxtPolicyGatewayLocaleService_tier1(t *testing.T) {
+	testAccResourceNsxtPolicyGatewayLocaleService(t, false)
+}
+
+func TestAccResourceNsxtPolicyGatewayLocaleService_tier0(t *testing.T) {
+	testAccResourceNsxtPolicyGatewayLocaleService(t, true)
+}
+
+func testAccResourceNsxtPolicyGatewayLocaleService(t *testing.T, isT0 bool) {
+	testResourceName := "nsxt_policy_gateway_locale_service.test"
+	displayName := getAccTestResourceName()
+	updatedDisplayName := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGatewayLocaleServiceCheckDestroy(state, updatedDisplayName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayLocaleServiceTemplate(isT0, displayName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayLocaleServiceExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", displayName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttrSet(testResourceName, "gateway_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_cluster_path"),
+					resource.TestCheckResourceAttr(testResourceName, "preferred_edge_paths.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "preferred_edge_paths.0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGatewayLocaleServiceTemplate(isT0, updatedDisplayName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayLocaleServiceExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedDisplayName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttrSet(testResourceName, "gateway_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_cluster_path"),
+					resource.TestCheckResourceAttr(testResourceName, "preferred_edge_paths.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyGatewayLocaleService_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_gateway_locale_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGatewayLocaleServiceCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayLocaleServiceTemplate(false, name, true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNSXPolicyGatewayLocaleServiceImporterGetID(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewayLocaleServiceExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Locale Service resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Locale Service resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyGatewayLocaleServiceExists(rs.Primary.Attributes["gateway_path"])(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Locale Service %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyGatewayLocaleServiceCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_gateway_locale_service" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyGatewayLocaleServiceExists(rs.Primary.Attributes["gateway_path"])(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Policy Locale Service %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXPolicyGatewayLocaleServiceImporterGetID(resourceName string) func(*terraform.State) (string, error) {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("NSX Policy Locale Service resource %s not found in resources", resourceName)
+		}
+		path := rs.Primary.Attributes["path"]
+		if path == "" {
+			return "", fmt.Errorf("NSX Policy Locale Service path not set in resources")
+		}
+		return path, nil
+	}
+}
+
+func testAccNsxtPolicyGatewayLocaleServiceTemplate(isT0 bool, displayName string, withEdgeNode bool) string {
+	gateway := "nsxt_policy_tier1_gateway"
+	if isT0 {
+		gateway = "nsxt_policy_tier0_gateway"
+	}
+	extraConfig := ""
+	if withEdgeNode {
+		extraConfig = `
+  preferred_edge_paths = [data.nsxt_policy_edge_node.test.path]
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }`
+	}
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) + fmt.Sprintf(`
+data "nsxt_policy_edge_node" "test" {
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+  member_index      = 0
+}
+
+resource "%s" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_gateway_locale_service" "test" {
+  gateway_path      = %s.test.path
+  display_name      = "%s"
+  description       = "terraform created"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+  %s
+}`, gateway, accTestPolicyGatewayLocaleServiceHelperName, gateway, displayName, extraConfig)
+}
@@ -104,6 +104,11 @@ func resourceNsxtPolicyGatewayPolicyCreate(d *schema.ResourceData, m interface{}
 		obj.TcpStrict = &tcpStrict
 	}
 
+	obj.SchedulerPath, err = getPolicySchedulerPathFromSchema(d)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Creating Gateway Policy with ID %s", id)
 	if isPolicyGlobalManager(m) {
 		client := gm_domains.NewDefaultGatewayPoliciesClient(connector)
@@ -150,6 +155,7 @@ func resourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{})
 	d.Set("locked", obj.Locked)
 	d.Set("sequence_number", obj.SequenceNumber)
 	d.Set("stateful", obj.Stateful)
+	d.Set("scheduler_path", obj.SchedulerPath)
 	if obj.TcpStrict != nil {
 		// tcp_strict is dependant on stateful and maybe nil
 		d.Set("tcp_strict", *obj.TcpStrict)
@@ -176,7 +182,7 @@ func resourceNsxtPolicyGatewayPolicyUpdate(d *schema.ResourceData, m interface{}
 	stateful := d.Get("stateful").(bool)
 	tcpStrict := d.Get("tcp_strict").(bool)
 	rules := getPolicyRulesFromSchema(d, false)
-	revision := int64(d.Get("revision").(int))
+	domain := d.Get("domain").(string)
 
 	obj := model.GatewayPolicy{
 		DisplayName:    &displayName,
@@ -188,28 +194,45 @@ func resourceNsxtPolicyGatewayPolicyUpdate(d *schema.ResourceData, m interface{}
 		SequenceNumber: &sequenceNumber,
 		Stateful:       &stateful,
 		TcpStrict:      &tcpStrict,
-		Revision:       &revision,
 		Rules:          rules,
 	}
 
-	var err error
-	if isPolicyGlobalManager(m) {
-		rawObj, err1 := convertModelBindingType(obj, model.GatewayPolicyBindingType(), gm_model.GatewayPolicyBindingType())
-		if err1 != nil {
-			return err1
+	schedulerPath, err := getPolicySchedulerPathFromSchema(d)
+	if err != nil {
+		return err
+	}
+	obj.SchedulerPath = schedulerPath
+
+	doUpdate := func(revision int64) error {
+		obj.Revision = &revision
+		if isPolicyGlobalManager(m) {
+			rawObj, err := convertModelBindingType(obj, model.GatewayPolicyBindingType(), gm_model.GatewayPolicyBindingType())
+			if err != nil {
+				return err
+			}
+			gmObj := rawObj.(gm_model.GatewayPolicy)
+			client := gm_domains.NewDefaultGatewayPoliciesClient(connector)
+			// We need to use PUT, because PATCH will not replace the whole rule list
+			_, err = client.Update(domain, id, gmObj)
+			return err
 		}
-		gmObj := rawObj.(gm_model.GatewayPolicy)
-		client := gm_domains.NewDefaultGatewayPoliciesClient(connector)
-		// We need to use PUT, because PATCH will not replace the whole rule list
-		_, err = client.Update(d.Get("domain").(string), id, gmObj)
-	} else {
 		client := domains.NewDefaultGatewayPoliciesClient(connector)
 		// We need to use PUT, because PATCH will not replace the whole rule list
-		_, err = client.Update(d.Get("domain").(string), id, obj)
+		_, err := client.Update(domain, id, obj)
+		return err
+	}
+
+	getRevision := func() (int64, error) {
+		policy, err := getGatewayPolicyInDomain(id, domain, connector, isPolicyGlobalManager(m))
+		if err != nil {
+			return 0, err
+		}
+		return *policy.Revision, nil
 	}
 
+	err = updateUponRevisionConflict(d, doUpdate, getRevision)
 	if err != nil {
-		return handleUpdateError("Gateway Policy", id, err)
+		return handleRevisionConflictError("Gateway Policy", id, err)
 	}
 
 	return resourceNsxtPolicyGatewayPolicyRead(d, m)
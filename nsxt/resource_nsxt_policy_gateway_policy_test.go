@@ -208,9 +208,10 @@ func TestAccResourceNsxtPolicyGatewayPolicy_importBasic(t *testing.T) {
 				Config: testAccNsxtPolicyGatewayPolicyBasic(name, "import"),
 			},
 			{
-				ResourceName:      testResourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"refresh_on_revision_conflict"},
 			},
 		},
 	})
@@ -231,9 +232,10 @@ func TestAccResourceNsxtPolicyGatewayPolicy_importNoTcpStrict(t *testing.T) {
 				Config: testAccNsxtPolicyGatewayPolicyBasicNoTCPStrict(name, "import"),
 			},
 			{
-				ResourceName:      testResourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"refresh_on_revision_conflict"},
 			},
 		},
 	})
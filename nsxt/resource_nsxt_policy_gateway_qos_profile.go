@@ -0,0 +1,237 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var gatewayQosProfileExcessActionValues = []string{
+	model.GatewayQosProfile_EXCESS_ACTION_DROP,
+}
+
+func resourceNsxtPolicyGatewayQosProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyGatewayQosProfileCreate,
+		Read:   resourceNsxtPolicyGatewayQosProfileRead,
+		Update: resourceNsxtPolicyGatewayQosProfileUpdate,
+		Delete: resourceNsxtPolicyGatewayQosProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"burst_size": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum amount of traffic that can be transmitted at peak bandwidth rate (bytes)",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"committed_bandwidth": {
+				Type:         schema.TypeInt,
+				Description:  "Committed bandwidth in both directions specified in Mbps",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"excess_action": {
+				Type:         schema.TypeString,
+				Description:  "Action on traffic exceeding bandwidth",
+				Optional:     true,
+				Default:      model.GatewayQosProfile_EXCESS_ACTION_DROP,
+				ValidateFunc: validation.StringInSlice(gatewayQosProfileExcessActionValues, false),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyGatewayQosProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDefaultGatewayQosProfilesClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewDefaultGatewayQosProfilesClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getGatewayQosProfileFromSchema(d *schema.ResourceData) model.GatewayQosProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	excessAction := d.Get("excess_action").(string)
+
+	obj := model.GatewayQosProfile{
+		DisplayName:  &displayName,
+		Description:  &description,
+		Tags:         tags,
+		ExcessAction: &excessAction,
+	}
+
+	if burstSize, ok := d.GetOk("burst_size"); ok {
+		value := int64(burstSize.(int))
+		obj.BurstSize = &value
+	}
+
+	if committedBandwidth, ok := d.GetOk("committed_bandwidth"); ok {
+		value := int64(committedBandwidth.(int))
+		obj.CommittedBandwitdth = &value
+	}
+
+	return obj
+}
+
+func patchNsxtPolicyGatewayQosProfile(connector *client.RestConnector, id string, obj model.GatewayQosProfile, isGlobalManager bool) error {
+	boolFalse := false
+	if isGlobalManager {
+		gmObj, err := convertModelBindingType(obj, model.GatewayQosProfileBindingType(), gm_model.GatewayQosProfileBindingType())
+		if err != nil {
+			return err
+		}
+
+		client := gm_infra.NewDefaultGatewayQosProfilesClient(connector)
+		return client.Patch(id, gmObj.(gm_model.GatewayQosProfile), &boolFalse)
+	}
+
+	client := infra.NewDefaultGatewayQosProfilesClient(connector)
+	return client.Patch(id, obj, &boolFalse)
+}
+
+func resourceNsxtPolicyGatewayQosProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyGatewayQosProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getGatewayQosProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating GatewayQosProfile with ID %s", id)
+	err = patchNsxtPolicyGatewayQosProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleCreateError("GatewayQosProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyGatewayQosProfileRead(d, m)
+}
+
+func resourceNsxtPolicyGatewayQosProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining GatewayQosProfile ID")
+	}
+
+	var obj model.GatewayQosProfile
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultGatewayQosProfilesClient(connector)
+		gmObj, err := client.Get(id)
+		if err != nil {
+			return handleReadError(d, "GatewayQosProfile", id, err)
+		}
+		rawObj, err := convertModelBindingType(gmObj, gm_model.GatewayQosProfileBindingType(), model.GatewayQosProfileBindingType())
+		if err != nil {
+			return err
+		}
+		obj = rawObj.(model.GatewayQosProfile)
+	} else {
+		var err error
+		client := infra.NewDefaultGatewayQosProfilesClient(connector)
+		obj, err = client.Get(id)
+		if err != nil {
+			return handleReadError(d, "GatewayQosProfile", id, err)
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("burst_size", obj.BurstSize)
+	d.Set("committed_bandwidth", obj.CommittedBandwitdth)
+	d.Set("excess_action", obj.ExcessAction)
+
+	return nil
+}
+
+func resourceNsxtPolicyGatewayQosProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining GatewayQosProfile ID")
+	}
+
+	obj := getGatewayQosProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	log.Printf("[INFO] Updating GatewayQosProfile with ID %s", id)
+	err := patchNsxtPolicyGatewayQosProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleUpdateError("GatewayQosProfile", id, err)
+	}
+
+	return resourceNsxtPolicyGatewayQosProfileRead(d, m)
+}
+
+func resourceNsxtPolicyGatewayQosProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining GatewayQosProfile ID")
+	}
+
+	var err error
+	connector := getPolicyConnector(m)
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultGatewayQosProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	} else {
+		client := infra.NewDefaultGatewayQosProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	}
+
+	if err != nil {
+		return handleDeleteError("GatewayQosProfile", id, err)
+	}
+
+	return nil
+}
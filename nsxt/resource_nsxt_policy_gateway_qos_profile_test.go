@@ -0,0 +1,136 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyGatewayQosProfileCreateAttributes = map[string]string{
+	"display_name":        getAccTestResourceName(),
+	"description":         "terraform created",
+	"burst_size":          "10",
+	"committed_bandwidth": "20",
+	"excess_action":       "DROP",
+}
+
+var accTestPolicyGatewayQosProfileUpdateAttributes = map[string]string{
+	"display_name":        getAccTestResourceName(),
+	"description":         "terraform updated",
+	"burst_size":          "50",
+	"committed_bandwidth": "100",
+	"excess_action":       "DROP",
+}
+
+func TestAccResourceNsxtPolicyGatewayQosProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_gateway_qos_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGatewayQosProfileCheckDestroy(state, accTestPolicyGatewayQosProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayQosProfileTemplate(true),
+				Check:  testAccNsxtPolicyGatewayQosProfileCheckAttributes(testResourceName, accTestPolicyGatewayQosProfileCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyGatewayQosProfileTemplate(false),
+				Check:  testAccNsxtPolicyGatewayQosProfileCheckAttributes(testResourceName, accTestPolicyGatewayQosProfileUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyGatewayQosProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayQosProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyGatewayQosProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_gateway_qos_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGatewayQosProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayQosProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewayQosProfileCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyGatewayQosProfileExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyGatewayQosProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyGatewayQosProfileExists)
+}
+
+func testAccNsxtPolicyGatewayQosProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_gateway_qos_profile", resourceNsxtPolicyGatewayQosProfileExists)
+}
+
+func testAccNsxtPolicyGatewayQosProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyGatewayQosProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyGatewayQosProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_gateway_qos_profile" "test" {
+  display_name        = "%s"
+  description         = "%s"
+  burst_size          = %s
+  committed_bandwidth = %s
+  excess_action       = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["burst_size"], attrMap["committed_bandwidth"], attrMap["excess_action"])
+}
+
+func testAccNsxtPolicyGatewayQosProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_gateway_qos_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyGatewayQosProfileUpdateAttributes["display_name"])
+}
@@ -152,7 +152,7 @@ func resourceNsxtPolicyIPAddressAllocationRead(d *schema.ResourceData, m interfa
 	if d.Get("allocation_ip").(string) == "" {
 		log.Printf("[DEBUG] Waiting for realization of IP Address for IP Allocation with ID %s", id)
 
-		stateConf := nsxtPolicyWaitForRealizationStateConf(connector, d, d.Get("path").(string))
+		stateConf := nsxtPolicyWaitForRealizationStateConf(connector, d, m, d.Get("path").(string))
 		entity, err := stateConf.WaitForState()
 		if err != nil {
 			return err
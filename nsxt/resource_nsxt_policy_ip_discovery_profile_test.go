@@ -0,0 +1,163 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIPDiscoveryProfileCreateAttributes = map[string]string{
+	"display_name":                   getAccTestResourceName(),
+	"description":                    "terraform created",
+	"arp_nd_binding_timeout":         "20",
+	"duplicate_ip_detection_enabled": "true",
+	"arp_binding_limit":              "140",
+	"arp_snooping_enabled":           "true",
+	"dhcp_snooping_enabled":          "true",
+	"vmtools_enabled":                "true",
+	"dhcp_snooping_v6_enabled":       "true",
+	"nd_snooping_enabled":            "true",
+	"nd_snooping_limit":              "12",
+	"vmtools_v6_enabled":             "true",
+	"tofu_enabled":                   "true",
+}
+
+var accTestPolicyIPDiscoveryProfileUpdateAttributes = map[string]string{
+	"display_name":                   getAccTestResourceName(),
+	"description":                    "terraform updated",
+	"arp_nd_binding_timeout":         "50",
+	"duplicate_ip_detection_enabled": "false",
+	"arp_binding_limit":              "10",
+	"arp_snooping_enabled":           "false",
+	"dhcp_snooping_enabled":          "false",
+	"vmtools_enabled":                "false",
+	"dhcp_snooping_v6_enabled":       "false",
+	"nd_snooping_enabled":            "false",
+	"nd_snooping_limit":              "5",
+	"vmtools_v6_enabled":             "false",
+	"tofu_enabled":                   "false",
+}
+
+func TestAccResourceNsxtPolicyIPDiscoveryProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ip_discovery_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPDiscoveryProfileCheckDestroy(state, accTestPolicyIPDiscoveryProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPDiscoveryProfileTemplate(true),
+				Check:  testAccNsxtPolicyIPDiscoveryProfileCheckAttributes(testResourceName, accTestPolicyIPDiscoveryProfileCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPDiscoveryProfileTemplate(false),
+				Check:  testAccNsxtPolicyIPDiscoveryProfileCheckAttributes(testResourceName, accTestPolicyIPDiscoveryProfileUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPDiscoveryProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIPDiscoveryProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIPDiscoveryProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_ip_discovery_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPDiscoveryProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPDiscoveryProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIPDiscoveryProfileCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyIPDiscoveryProfileExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyIPDiscoveryProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyIPDiscoveryProfileExists)
+}
+
+func testAccNsxtPolicyIPDiscoveryProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_ip_discovery_profile", resourceNsxtPolicyIPDiscoveryProfileExists)
+}
+
+func testAccNsxtPolicyIPDiscoveryProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyIPDiscoveryProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyIPDiscoveryProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_ip_discovery_profile" "test" {
+  display_name = "%s"
+  description  = "%s"
+
+  arp_nd_binding_timeout         = %s
+  duplicate_ip_detection_enabled = %s
+
+  arp_binding_limit     = %s
+  arp_snooping_enabled  = %s
+  dhcp_snooping_enabled = %s
+  vmtools_enabled       = %s
+
+  dhcp_snooping_v6_enabled = %s
+  nd_snooping_enabled      = %s
+  nd_snooping_limit        = %s
+  vmtools_v6_enabled       = %s
+  tofu_enabled             = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["arp_nd_binding_timeout"], attrMap["duplicate_ip_detection_enabled"], attrMap["arp_binding_limit"], attrMap["arp_snooping_enabled"], attrMap["dhcp_snooping_enabled"], attrMap["vmtools_enabled"], attrMap["dhcp_snooping_v6_enabled"], attrMap["nd_snooping_enabled"], attrMap["nd_snooping_limit"], attrMap["vmtools_v6_enabled"], attrMap["tofu_enabled"])
+}
+
+func testAccNsxtPolicyIPDiscoveryProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_ip_discovery_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyIPDiscoveryProfileUpdateAttributes["display_name"])
+}
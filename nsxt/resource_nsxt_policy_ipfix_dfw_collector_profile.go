@@ -0,0 +1,214 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyIpfixDfwCollectorProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIpfixDfwCollectorProfileCreate,
+		Read:   resourceNsxtPolicyIpfixDfwCollectorProfileRead,
+		Update: resourceNsxtPolicyIpfixDfwCollectorProfileUpdate,
+		Delete: resourceNsxtPolicyIpfixDfwCollectorProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"collector":    getPolicyIpfixCollectorSchema(),
+		},
+	}
+}
+
+func resourceNsxtPolicyIpfixDfwCollectorProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDefaultIpfixDfwCollectorProfilesClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewDefaultIpfixDfwCollectorProfilesClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getIpfixDfwCollectorProfileFromSchema(d *schema.ResourceData) model.IPFIXDFWCollectorProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+
+	var collectors []model.IPFIXDFWCollector
+	for _, item := range d.Get("collector").([]interface{}) {
+		data := item.(map[string]interface{})
+		ipAddress := data["ip_address"].(string)
+		port := int64(data["port"].(int))
+		collectors = append(collectors, model.IPFIXDFWCollector{
+			CollectorIpAddress: &ipAddress,
+			CollectorPort:      &port,
+		})
+	}
+
+	return model.IPFIXDFWCollectorProfile{
+		DisplayName:        &displayName,
+		Description:        &description,
+		Tags:               tags,
+		IpfixDfwCollectors: collectors,
+	}
+}
+
+func patchNsxtPolicyIpfixDfwCollectorProfile(connector *client.RestConnector, id string, obj model.IPFIXDFWCollectorProfile, isGlobalManager bool) error {
+	boolFalse := false
+	if isGlobalManager {
+		gmObj, err := convertModelBindingType(obj, model.IPFIXDFWCollectorProfileBindingType(), gm_model.IPFIXDFWCollectorProfileBindingType())
+		if err != nil {
+			return err
+		}
+
+		client := gm_infra.NewDefaultIpfixDfwCollectorProfilesClient(connector)
+		return client.Patch(id, gmObj.(gm_model.IPFIXDFWCollectorProfile), &boolFalse)
+	}
+
+	client := infra.NewDefaultIpfixDfwCollectorProfilesClient(connector)
+	return client.Patch(id, obj, &boolFalse)
+}
+
+func resourceNsxtPolicyIpfixDfwCollectorProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIpfixDfwCollectorProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getIpfixDfwCollectorProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating IpfixDfwCollectorProfile with ID %s", id)
+	err = patchNsxtPolicyIpfixDfwCollectorProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleCreateError("IpfixDfwCollectorProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIpfixDfwCollectorProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpfixDfwCollectorProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IpfixDfwCollectorProfile ID")
+	}
+
+	var obj model.IPFIXDFWCollectorProfile
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultIpfixDfwCollectorProfilesClient(connector)
+		gmObj, err := client.Get(id)
+		if err != nil {
+			return handleReadError(d, "IpfixDfwCollectorProfile", id, err)
+		}
+		rawObj, err := convertModelBindingType(gmObj, gm_model.IPFIXDFWCollectorProfileBindingType(), model.IPFIXDFWCollectorProfileBindingType())
+		if err != nil {
+			return err
+		}
+		obj = rawObj.(model.IPFIXDFWCollectorProfile)
+	} else {
+		var err error
+		client := infra.NewDefaultIpfixDfwCollectorProfilesClient(connector)
+		obj, err = client.Get(id)
+		if err != nil {
+			return handleReadError(d, "IpfixDfwCollectorProfile", id, err)
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	var collectors []interface{}
+	for _, collector := range obj.IpfixDfwCollectors {
+		elem := make(map[string]interface{})
+		elem["ip_address"] = collector.CollectorIpAddress
+		elem["port"] = collector.CollectorPort
+		collectors = append(collectors, elem)
+	}
+
+	return d.Set("collector", collectors)
+}
+
+func resourceNsxtPolicyIpfixDfwCollectorProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IpfixDfwCollectorProfile ID")
+	}
+
+	obj := getIpfixDfwCollectorProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	log.Printf("[INFO] Updating IpfixDfwCollectorProfile with ID %s", id)
+	err := patchNsxtPolicyIpfixDfwCollectorProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleUpdateError("IpfixDfwCollectorProfile", id, err)
+	}
+
+	return resourceNsxtPolicyIpfixDfwCollectorProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpfixDfwCollectorProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IpfixDfwCollectorProfile ID")
+	}
+
+	var err error
+	connector := getPolicyConnector(m)
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultIpfixDfwCollectorProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	} else {
+		client := infra.NewDefaultIpfixDfwCollectorProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	}
+
+	if err != nil {
+		return handleDeleteError("IpfixDfwCollectorProfile", id, err)
+	}
+
+	return nil
+}
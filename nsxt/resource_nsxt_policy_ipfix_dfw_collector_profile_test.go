@@ -0,0 +1,111 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyIpfixDfwCollectorProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipfix_dfw_collector_profile.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpfixDfwCollectorProfileCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpfixDfwCollectorProfileTemplate(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpfixDfwCollectorProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.0.ip_address", "2.2.2.2"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.0.port", "4739"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIpfixDfwCollectorProfileTemplate(updatedName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpfixDfwCollectorProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "collector.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.1.ip_address", "3.3.3.3"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.1.port", "9000"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIpfixDfwCollectorProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_ipfix_dfw_collector_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpfixDfwCollectorProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpfixDfwCollectorProfileTemplate(name, true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIpfixDfwCollectorProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyIpfixDfwCollectorProfileExists)
+}
+
+func testAccNsxtPolicyIpfixDfwCollectorProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_ipfix_dfw_collector_profile", resourceNsxtPolicyIpfixDfwCollectorProfileExists)
+}
+
+func testAccNsxtPolicyIpfixDfwCollectorProfileTemplate(name string, multipleCollectors bool) string {
+	extraCollector := ""
+	if multipleCollectors {
+		extraCollector = `
+  collector {
+    ip_address = "3.3.3.3"
+    port       = 9000
+  }`
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipfix_dfw_collector_profile" "test" {
+  display_name = "%s"
+  description  = "terraform created"
+
+  collector {
+    ip_address = "2.2.2.2"
+  }
+%s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, extraCollector)
+}
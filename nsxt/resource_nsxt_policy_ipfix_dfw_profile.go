@@ -0,0 +1,229 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyIpfixDfwProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIpfixDfwProfileCreate,
+		Read:   resourceNsxtPolicyIpfixDfwProfileRead,
+		Update: resourceNsxtPolicyIpfixDfwProfileUpdate,
+		Delete: resourceNsxtPolicyIpfixDfwProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":                 getNsxIDSchema(),
+			"path":                   getPathSchema(),
+			"display_name":           getDisplayNameSchema(),
+			"description":            getDescriptionSchema(),
+			"revision":               getRevisionSchema(),
+			"tag":                    getTagsSchema(),
+			"collector_profile_path": getPolicyPathSchema(true, false, "Policy path of IPFIX DFW collector profile"),
+			"active_flow_export_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "For long standing active flows, IPFIX records will be sent per timeout period in minutes",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"observation_domain_id": {
+				Type:         schema.TypeInt,
+				Description:  "An identifier that is unique to the exporting process and used to meter the flows",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Description:  "Priority used to resolve conflicts when segment ports are covered by multiple IPFIX profiles, lower number has higher priority",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 65536),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIpfixDfwProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDefaultIpfixDfwProfilesClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewDefaultIpfixDfwProfilesClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getIpfixDfwProfileFromSchema(d *schema.ResourceData) model.IPFIXDFWProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	collectorProfilePath := d.Get("collector_profile_path").(string)
+	activeFlowExportTimeout := int64(d.Get("active_flow_export_timeout").(int))
+	observationDomainID := int64(d.Get("observation_domain_id").(int))
+	priority := int64(d.Get("priority").(int))
+
+	return model.IPFIXDFWProfile{
+		DisplayName:                  &displayName,
+		Description:                  &description,
+		Tags:                         tags,
+		IpfixDfwCollectorProfilePath: &collectorProfilePath,
+		ActiveFlowExportTimeout:      &activeFlowExportTimeout,
+		ObservationDomainId:          &observationDomainID,
+		Priority:                     &priority,
+	}
+}
+
+func patchNsxtPolicyIpfixDfwProfile(connector *client.RestConnector, id string, obj model.IPFIXDFWProfile, isGlobalManager bool) error {
+	boolFalse := false
+	if isGlobalManager {
+		gmObj, err := convertModelBindingType(obj, model.IPFIXDFWProfileBindingType(), gm_model.IPFIXDFWProfileBindingType())
+		if err != nil {
+			return err
+		}
+
+		client := gm_infra.NewDefaultIpfixDfwProfilesClient(connector)
+		return client.Patch(id, gmObj.(gm_model.IPFIXDFWProfile), &boolFalse)
+	}
+
+	client := infra.NewDefaultIpfixDfwProfilesClient(connector)
+	return client.Patch(id, obj, &boolFalse)
+}
+
+func resourceNsxtPolicyIpfixDfwProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIpfixDfwProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getIpfixDfwProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating IpfixDfwProfile with ID %s", id)
+	err = patchNsxtPolicyIpfixDfwProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleCreateError("IpfixDfwProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIpfixDfwProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpfixDfwProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IpfixDfwProfile ID")
+	}
+
+	var obj model.IPFIXDFWProfile
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultIpfixDfwProfilesClient(connector)
+		gmObj, err := client.Get(id)
+		if err != nil {
+			return handleReadError(d, "IpfixDfwProfile", id, err)
+		}
+		rawObj, err := convertModelBindingType(gmObj, gm_model.IPFIXDFWProfileBindingType(), model.IPFIXDFWProfileBindingType())
+		if err != nil {
+			return err
+		}
+		obj = rawObj.(model.IPFIXDFWProfile)
+	} else {
+		var err error
+		client := infra.NewDefaultIpfixDfwProfilesClient(connector)
+		obj, err = client.Get(id)
+		if err != nil {
+			return handleReadError(d, "IpfixDfwProfile", id, err)
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("collector_profile_path", obj.IpfixDfwCollectorProfilePath)
+	d.Set("active_flow_export_timeout", obj.ActiveFlowExportTimeout)
+	d.Set("observation_domain_id", obj.ObservationDomainId)
+	d.Set("priority", obj.Priority)
+
+	return nil
+}
+
+func resourceNsxtPolicyIpfixDfwProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IpfixDfwProfile ID")
+	}
+
+	obj := getIpfixDfwProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	log.Printf("[INFO] Updating IpfixDfwProfile with ID %s", id)
+	err := patchNsxtPolicyIpfixDfwProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleUpdateError("IpfixDfwProfile", id, err)
+	}
+
+	return resourceNsxtPolicyIpfixDfwProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpfixDfwProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IpfixDfwProfile ID")
+	}
+
+	var err error
+	connector := getPolicyConnector(m)
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultIpfixDfwProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	} else {
+		client := infra.NewDefaultIpfixDfwProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	}
+
+	if err != nil {
+		return handleDeleteError("IpfixDfwProfile", id, err)
+	}
+
+	return nil
+}
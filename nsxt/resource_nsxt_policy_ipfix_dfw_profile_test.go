@@ -0,0 +1,153 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIpfixDfwProfileCreateAttributes = map[string]string{
+	"display_name":               getAccTestResourceName(),
+	"description":                "terraform created",
+	"active_flow_export_timeout": "10",
+	"observation_domain_id":      "12",
+	"priority":                   "10",
+}
+
+var accTestPolicyIpfixDfwProfileUpdateAttributes = map[string]string{
+	"display_name":               getAccTestResourceName(),
+	"description":                "terraform updated",
+	"active_flow_export_timeout": "1",
+	"observation_domain_id":      "20",
+	"priority":                   "5",
+}
+
+var accTestPolicyIpfixDfwProfileCollectorName = getAccTestResourceName()
+
+func TestAccResourceNsxtPolicyIpfixDfwProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipfix_dfw_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpfixDfwProfileCheckDestroy(state, accTestPolicyIpfixDfwProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpfixDfwProfileTemplate(true),
+				Check:  testAccNsxtPolicyIpfixDfwProfileCheckAttributes(testResourceName, accTestPolicyIpfixDfwProfileCreateAttributes),
+			},
+			{
+				Config: testAccNsxtPolicyIpfixDfwProfileTemplate(false),
+				Check:  testAccNsxtPolicyIpfixDfwProfileCheckAttributes(testResourceName, accTestPolicyIpfixDfwProfileUpdateAttributes),
+			},
+			{
+				Config: testAccNsxtPolicyIpfixDfwProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpfixDfwProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(testResourceName, "collector_profile_path", "nsxt_policy_ipfix_dfw_collector_profile.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIpfixDfwProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipfix_dfw_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpfixDfwProfileCheckDestroy(state, accTestPolicyIpfixDfwProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpfixDfwProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIpfixDfwProfileCheckAttributes(resourceName string, attrMap map[string]string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyIpfixDfwProfileExists(resourceName),
+		resource.TestCheckResourceAttrPair(resourceName, "collector_profile_path", "nsxt_policy_ipfix_dfw_collector_profile.test", "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyIpfixDfwProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyIpfixDfwProfileExists)
+}
+
+func testAccNsxtPolicyIpfixDfwProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_ipfix_dfw_profile", resourceNsxtPolicyIpfixDfwProfileExists)
+}
+
+func testAccNsxtPolicyIpfixDfwProfilePrerequisites() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipfix_dfw_collector_profile" "test" {
+  display_name = "%s"
+
+  collector {
+    ip_address = "2.2.2.2"
+  }
+}`, accTestPolicyIpfixDfwProfileCollectorName)
+}
+
+func testAccNsxtPolicyIpfixDfwProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyIpfixDfwProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyIpfixDfwProfileUpdateAttributes
+	}
+	return testAccNsxtPolicyIpfixDfwProfilePrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_ipfix_dfw_profile" "test" {
+  display_name           = "%s"
+  description            = "%s"
+  collector_profile_path = nsxt_policy_ipfix_dfw_collector_profile.test.path
+
+  active_flow_export_timeout = %s
+  observation_domain_id      = %s
+  priority                   = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["active_flow_export_timeout"], attrMap["observation_domain_id"], attrMap["priority"])
+}
+
+func testAccNsxtPolicyIpfixDfwProfileMinimalistic() string {
+	return testAccNsxtPolicyIpfixDfwProfilePrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_ipfix_dfw_profile" "test" {
+  display_name           = "%s"
+  collector_profile_path = nsxt_policy_ipfix_dfw_collector_profile.test.path
+}`, accTestPolicyIpfixDfwProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,243 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyIpfixL2CollectorProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIpfixL2CollectorProfileCreate,
+		Read:   resourceNsxtPolicyIpfixL2CollectorProfileRead,
+		Update: resourceNsxtPolicyIpfixL2CollectorProfileUpdate,
+		Delete: resourceNsxtPolicyIpfixL2CollectorProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"collector":    getPolicyIpfixCollectorSchema(),
+		},
+	}
+}
+
+// Collector schema shared by L2 and DFW IPFIX collector profiles
+func getPolicyIpfixCollectorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "IPFIX collectors",
+		Required:    true,
+		MinItems:    1,
+		MaxItems:    4,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ip_address": {
+					Type:         schema.TypeString,
+					Description:  "IP address of the IPFIX collector",
+					Required:     true,
+					ValidateFunc: validateSingleIP(),
+				},
+				"port": {
+					Type:         schema.TypeInt,
+					Description:  "Port of the IPFIX collector",
+					Optional:     true,
+					Default:      4739,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIpfixL2CollectorProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDefaultIpfixL2CollectorProfilesClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewDefaultIpfixL2CollectorProfilesClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getIpfixL2CollectorProfileFromSchema(d *schema.ResourceData) model.IPFIXL2CollectorProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+
+	var collectors []model.IPFIXL2Collector
+	for _, item := range d.Get("collector").([]interface{}) {
+		data := item.(map[string]interface{})
+		ipAddress := data["ip_address"].(string)
+		port := int64(data["port"].(int))
+		collectors = append(collectors, model.IPFIXL2Collector{
+			CollectorIpAddress: &ipAddress,
+			CollectorPort:      &port,
+		})
+	}
+
+	return model.IPFIXL2CollectorProfile{
+		DisplayName:       &displayName,
+		Description:       &description,
+		Tags:              tags,
+		IpfixL2Collectors: collectors,
+	}
+}
+
+func patchNsxtPolicyIpfixL2CollectorProfile(connector *client.RestConnector, id string, obj model.IPFIXL2CollectorProfile, isGlobalManager bool) error {
+	boolFalse := false
+	if isGlobalManager {
+		gmObj, err := convertModelBindingType(obj, model.IPFIXL2CollectorProfileBindingType(), gm_model.IPFIXL2CollectorProfileBindingType())
+		if err != nil {
+			return err
+		}
+
+		client := gm_infra.NewDefaultIpfixL2CollectorProfilesClient(connector)
+		return client.Patch(id, gmObj.(gm_model.IPFIXL2CollectorProfile), &boolFalse)
+	}
+
+	client := infra.NewDefaultIpfixL2CollectorProfilesClient(connector)
+	return client.Patch(id, obj, &boolFalse)
+}
+
+func resourceNsxtPolicyIpfixL2CollectorProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIpfixL2CollectorProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getIpfixL2CollectorProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating IpfixL2CollectorProfile with ID %s", id)
+	err = patchNsxtPolicyIpfixL2CollectorProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleCreateError("IpfixL2CollectorProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIpfixL2CollectorProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpfixL2CollectorProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IpfixL2CollectorProfile ID")
+	}
+
+	var obj model.IPFIXL2CollectorProfile
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultIpfixL2CollectorProfilesClient(connector)
+		gmObj, err := client.Get(id)
+		if err != nil {
+			return handleReadError(d, "IpfixL2CollectorProfile", id, err)
+		}
+		rawObj, err := convertModelBindingType(gmObj, gm_model.IPFIXL2CollectorProfileBindingType(), model.IPFIXL2CollectorProfileBindingType())
+		if err != nil {
+			return err
+		}
+		obj = rawObj.(model.IPFIXL2CollectorProfile)
+	} else {
+		var err error
+		client := infra.NewDefaultIpfixL2CollectorProfilesClient(connector)
+		obj, err = client.Get(id)
+		if err != nil {
+			return handleReadError(d, "IpfixL2CollectorProfile", id, err)
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	var collectors []interface{}
+	for _, collector := range obj.IpfixL2Collectors {
+		elem := make(map[string]interface{})
+		elem["ip_address"] = collector.CollectorIpAddress
+		elem["port"] = collector.CollectorPort
+		collectors = append(collectors, elem)
+	}
+
+	return d.Set("collector", collectors)
+}
+
+func resourceNsxtPolicyIpfixL2CollectorProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IpfixL2CollectorProfile ID")
+	}
+
+	obj := getIpfixL2CollectorProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	log.Printf("[INFO] Updating IpfixL2CollectorProfile with ID %s", id)
+	err := patchNsxtPolicyIpfixL2CollectorProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleUpdateError("IpfixL2CollectorProfile", id, err)
+	}
+
+	return resourceNsxtPolicyIpfixL2CollectorProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpfixL2CollectorProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IpfixL2CollectorProfile ID")
+	}
+
+	var err error
+	connector := getPolicyConnector(m)
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultIpfixL2CollectorProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	} else {
+		client := infra.NewDefaultIpfixL2CollectorProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	}
+
+	if err != nil {
+		return handleDeleteError("IpfixL2CollectorProfile", id, err)
+	}
+
+	return nil
+}
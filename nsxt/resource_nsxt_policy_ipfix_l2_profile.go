@@ -0,0 +1,268 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyIpfixL2Profile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIpfixL2ProfileCreate,
+		Read:   resourceNsxtPolicyIpfixL2ProfileRead,
+		Update: resourceNsxtPolicyIpfixL2ProfileUpdate,
+		Delete: resourceNsxtPolicyIpfixL2ProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":                 getNsxIDSchema(),
+			"path":                   getPathSchema(),
+			"display_name":           getDisplayNameSchema(),
+			"description":            getDescriptionSchema(),
+			"revision":               getRevisionSchema(),
+			"tag":                    getTagsSchema(),
+			"collector_profile_path": getPolicyPathSchema(true, false, "Policy path of IPFIX L2 collector profile"),
+			"active_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "The time in seconds after a flow is expired even if more packets matching this flow are received by the cache",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(60, 3600),
+			},
+			"idle_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "The time in seconds after a flow is expired if no more packets matching this flow are received by the cache",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(60, 3600),
+			},
+			"export_overlay_flow": {
+				Type:        schema.TypeBool,
+				Description: "Whether overlay flow info is included in the sample result",
+				Optional:    true,
+				Default:     true,
+			},
+			"max_flows": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of flow entries in each exporter flow cache",
+				Optional:     true,
+				Default:      16384,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"observation_domain_id": {
+				Type:         schema.TypeInt,
+				Description:  "An identifier that is unique to the exporting process and used to meter the flows",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"packet_sample_probability": {
+				Type:         schema.TypeFloat,
+				Description:  "The probability in percentage that a packet is sampled",
+				Optional:     true,
+				Default:      0.1,
+				ValidateFunc: validation.FloatBetween(0, 100),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Description:  "Priority used to resolve conflicts when segment ports are covered by multiple IPFIX profiles, lower number has higher priority",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIpfixL2ProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDefaultIpfixL2ProfilesClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewDefaultIpfixL2ProfilesClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getIpfixL2ProfileFromSchema(d *schema.ResourceData) model.IPFIXL2Profile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	collectorProfilePath := d.Get("collector_profile_path").(string)
+	activeTimeout := int64(d.Get("active_timeout").(int))
+	idleTimeout := int64(d.Get("idle_timeout").(int))
+	exportOverlayFlow := d.Get("export_overlay_flow").(bool)
+	maxFlows := int64(d.Get("max_flows").(int))
+	observationDomainID := int64(d.Get("observation_domain_id").(int))
+	packetSampleProbability := d.Get("packet_sample_probability").(float64)
+	priority := int64(d.Get("priority").(int))
+
+	return model.IPFIXL2Profile{
+		DisplayName:               &displayName,
+		Description:               &description,
+		Tags:                      tags,
+		IpfixCollectorProfilePath: &collectorProfilePath,
+		ActiveTimeout:             &activeTimeout,
+		IdleTimeout:               &idleTimeout,
+		ExportOverlayFlow:         &exportOverlayFlow,
+		MaxFlows:                  &maxFlows,
+		ObservationDomainId:       &observationDomainID,
+		PacketSampleProbability:   &packetSampleProbability,
+		Priority:                  &priority,
+	}
+}
+
+func patchNsxtPolicyIpfixL2Profile(connector *client.RestConnector, id string, obj model.IPFIXL2Profile, isGlobalManager bool) error {
+	boolFalse := false
+	if isGlobalManager {
+		gmObj, err := convertModelBindingType(obj, model.IPFIXL2ProfileBindingType(), gm_model.IPFIXL2ProfileBindingType())
+		if err != nil {
+			return err
+		}
+
+		client := gm_infra.NewDefaultIpfixL2ProfilesClient(connector)
+		return client.Patch(id, gmObj.(gm_model.IPFIXL2Profile), &boolFalse)
+	}
+
+	client := infra.NewDefaultIpfixL2ProfilesClient(connector)
+	return client.Patch(id, obj, &boolFalse)
+}
+
+func resourceNsxtPolicyIpfixL2ProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIpfixL2ProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getIpfixL2ProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating IpfixL2Profile with ID %s", id)
+	err = patchNsxtPolicyIpfixL2Profile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleCreateError("IpfixL2Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIpfixL2ProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpfixL2ProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IpfixL2Profile ID")
+	}
+
+	var obj model.IPFIXL2Profile
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultIpfixL2ProfilesClient(connector)
+		gmObj, err := client.Get(id)
+		if err != nil {
+			return handleReadError(d, "IpfixL2Profile", id, err)
+		}
+		rawObj, err := convertModelBindingType(gmObj, gm_model.IPFIXL2ProfileBindingType(), model.IPFIXL2ProfileBindingType())
+		if err != nil {
+			return err
+		}
+		obj = rawObj.(model.IPFIXL2Profile)
+	} else {
+		var err error
+		client := infra.NewDefaultIpfixL2ProfilesClient(connector)
+		obj, err = client.Get(id)
+		if err != nil {
+			return handleReadError(d, "IpfixL2Profile", id, err)
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("collector_profile_path", obj.IpfixCollectorProfilePath)
+	d.Set("active_timeout", obj.ActiveTimeout)
+	d.Set("idle_timeout", obj.IdleTimeout)
+	d.Set("export_overlay_flow", obj.ExportOverlayFlow)
+	d.Set("max_flows", obj.MaxFlows)
+	d.Set("observation_domain_id", obj.ObservationDomainId)
+	d.Set("packet_sample_probability", obj.PacketSampleProbability)
+	d.Set("priority", obj.Priority)
+
+	return nil
+}
+
+func resourceNsxtPolicyIpfixL2ProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IpfixL2Profile ID")
+	}
+
+	obj := getIpfixL2ProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	log.Printf("[INFO] Updating IpfixL2Profile with ID %s", id)
+	err := patchNsxtPolicyIpfixL2Profile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleUpdateError("IpfixL2Profile", id, err)
+	}
+
+	return resourceNsxtPolicyIpfixL2ProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpfixL2ProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IpfixL2Profile ID")
+	}
+
+	var err error
+	connector := getPolicyConnector(m)
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultIpfixL2ProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	} else {
+		client := infra.NewDefaultIpfixL2ProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	}
+
+	if err != nil {
+		return handleDeleteError("IpfixL2Profile", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIpfixL2ProfileCreateAttributes = map[string]string{
+	"display_name":              getAccTestResourceName(),
+	"description":               "terraform created",
+	"active_timeout":            "600",
+	"idle_timeout":              "120",
+	"export_overlay_flow":       "false",
+	"max_flows":                 "1000",
+	"observation_domain_id":     "12",
+	"packet_sample_probability": "1.5",
+	"priority":                  "10",
+}
+
+var accTestPolicyIpfixL2ProfileUpdateAttributes = map[string]string{
+	"display_name":              getAccTestResourceName(),
+	"description":               "terraform updated",
+	"active_timeout":            "300",
+	"idle_timeout":              "300",
+	"export_overlay_flow":       "true",
+	"max_flows":                 "16384",
+	"observation_domain_id":     "20",
+	"packet_sample_probability": "0.1",
+	"priority":                  "5",
+}
+
+var accTestPolicyIpfixL2ProfileCollectorName = getAccTestResourceName()
+
+func TestAccResourceNsxtPolicyIpfixL2Profile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipfix_l2_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpfixL2ProfileCheckDestroy(state, accTestPolicyIpfixL2ProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpfixL2ProfileTemplate(true),
+				Check:  testAccNsxtPolicyIpfixL2ProfileCheckAttributes(testResourceName, accTestPolicyIpfixL2ProfileCreateAttributes),
+			},
+			{
+				Config: testAccNsxtPolicyIpfixL2ProfileTemplate(false),
+				Check:  testAccNsxtPolicyIpfixL2ProfileCheckAttributes(testResourceName, accTestPolicyIpfixL2ProfileUpdateAttributes),
+			},
+			{
+				Config: testAccNsxtPolicyIpfixL2ProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpfixL2ProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(testResourceName, "collector_profile_path", "nsxt_policy_ipfix_l2_collector_profile.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIpfixL2Profile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipfix_l2_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpfixL2ProfileCheckDestroy(state, accTestPolicyIpfixL2ProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpfixL2ProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIpfixL2ProfileCheckAttributes(resourceName string, attrMap map[string]string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyIpfixL2ProfileExists(resourceName),
+		resource.TestCheckResourceAttrPair(resourceName, "collector_profile_path", "nsxt_policy_ipfix_l2_collector_profile.test", "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyIpfixL2ProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyIpfixL2ProfileExists)
+}
+
+func testAccNsxtPolicyIpfixL2ProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_ipfix_l2_profile", resourceNsxtPolicyIpfixL2ProfileExists)
+}
+
+func testAccNsxtPolicyIpfixL2ProfilePrerequisites() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipfix_l2_collector_profile" "test" {
+  display_name = "%s"
+
+  collector {
+    ip_address = "2.2.2.2"
+  }
+}`, accTestPolicyIpfixL2ProfileCollectorName)
+}
+
+func testAccNsxtPolicyIpfixL2ProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyIpfixL2ProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyIpfixL2ProfileUpdateAttributes
+	}
+	return testAccNsxtPolicyIpfixL2ProfilePrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_ipfix_l2_profile" "test" {
+  display_name           = "%s"
+  description            = "%s"
+  collector_profile_path = nsxt_policy_ipfix_l2_collector_profile.test.path
+
+  active_timeout            = %s
+  idle_timeout              = %s
+  export_overlay_flow       = %s
+  max_flows                 = %s
+  observation_domain_id     = %s
+  packet_sample_probability = %s
+  priority                  = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["active_timeout"], attrMap["idle_timeout"], attrMap["export_overlay_flow"], attrMap["max_flows"], attrMap["observation_domain_id"], attrMap["packet_sample_probability"], attrMap["priority"])
+}
+
+func testAccNsxtPolicyIpfixL2ProfileMinimalistic() string {
+	return testAccNsxtPolicyIpfixL2ProfilePrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_ipfix_l2_profile" "test" {
+  display_name           = "%s"
+  collector_profile_path = nsxt_policy_ipfix_l2_collector_profile.test.path
+}`, accTestPolicyIpfixL2ProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,149 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIPSecVpnDpdProfileCreateAttributes = map[string]string{
+	"display_name":       getAccTestResourceName(),
+	"description":        "terraform created",
+	"dpd_probe_interval": "60",
+	"dpd_probe_mode":     "PERIODIC",
+	"enabled":            "true",
+	"retry_count":        "8",
+}
+
+var accTestPolicyIPSecVpnDpdProfileUpdateAttributes = map[string]string{
+	"display_name":       getAccTestResourceName(),
+	"description":        "terraform updated",
+	"dpd_probe_interval": "10",
+	"dpd_probe_mode":     "ON_DEMAND",
+	"enabled":            "false",
+	"retry_count":        "12",
+}
+
+func TestAccResourceNsxtPolicyIPSecVpnDpdProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipsec_vpn_dpd_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnDpdProfileCheckDestroy(state, accTestPolicyIPSecVpnDpdProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnDpdProfileTemplate(true),
+				Check:  testAccNsxtPolicyIPSecVpnDpdProfileCheckAttributes(testResourceName, accTestPolicyIPSecVpnDpdProfileCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPSecVpnDpdProfileTemplate(false),
+				Check:  testAccNsxtPolicyIPSecVpnDpdProfileCheckAttributes(testResourceName, accTestPolicyIPSecVpnDpdProfileUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPSecVpnDpdProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIPSecVpnDpdProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "dpd_probe_mode", "PERIODIC"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "retry_count", "10"),
+					resource.TestCheckResourceAttrSet(testResourceName, "dpd_probe_interval"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIPSecVpnDpdProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_ipsec_vpn_dpd_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnDpdProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnDpdProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIPSecVpnDpdProfileCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyIPSecVpnDpdProfileExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyIPSecVpnDpdProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyIPSecVpnDpdProfileExists)
+}
+
+func testAccNsxtPolicyIPSecVpnDpdProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_ipsec_vpn_dpd_profile", resourceNsxtPolicyIPSecVpnDpdProfileExists)
+}
+
+func testAccNsxtPolicyIPSecVpnDpdProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyIPSecVpnDpdProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyIPSecVpnDpdProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_dpd_profile" "test" {
+  display_name       = "%s"
+  description        = "%s"
+  dpd_probe_interval = %s
+  dpd_probe_mode     = "%s"
+  enabled            = %s
+  retry_count        = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["dpd_probe_interval"], attrMap["dpd_probe_mode"], attrMap["enabled"], attrMap["retry_count"])
+}
+
+func testAccNsxtPolicyIPSecVpnDpdProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_dpd_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyIPSecVpnDpdProfileUpdateAttributes["display_name"])
+}
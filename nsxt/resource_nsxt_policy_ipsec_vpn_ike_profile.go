@@ -0,0 +1,249 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var ipSecVpnIkeProfileDhGroupValues = []string{
+	model.IPSecVpnIkeProfile_DH_GROUPS_GROUP2,
+	model.IPSecVpnIkeProfile_DH_GROUPS_GROUP5,
+	model.IPSecVpnIkeProfile_DH_GROUPS_GROUP14,
+	model.IPSecVpnIkeProfile_DH_GROUPS_GROUP15,
+	model.IPSecVpnIkeProfile_DH_GROUPS_GROUP16,
+	model.IPSecVpnIkeProfile_DH_GROUPS_GROUP19,
+	model.IPSecVpnIkeProfile_DH_GROUPS_GROUP20,
+	model.IPSecVpnIkeProfile_DH_GROUPS_GROUP21,
+}
+
+var ipSecVpnIkeProfileDigestAlgorithmValues = []string{
+	model.IPSecVpnIkeProfile_DIGEST_ALGORITHMS_SHA1,
+	model.IPSecVpnIkeProfile_DIGEST_ALGORITHMS_SHA2_256,
+	model.IPSecVpnIkeProfile_DIGEST_ALGORITHMS_SHA2_384,
+	model.IPSecVpnIkeProfile_DIGEST_ALGORITHMS_SHA2_512,
+}
+
+var ipSecVpnIkeProfileEncryptionAlgorithmValues = []string{
+	model.IPSecVpnIkeProfile_ENCRYPTION_ALGORITHMS_128,
+	model.IPSecVpnIkeProfile_ENCRYPTION_ALGORITHMS_256,
+	model.IPSecVpnIkeProfile_ENCRYPTION_ALGORITHMS_GCM_128,
+	model.IPSecVpnIkeProfile_ENCRYPTION_ALGORITHMS_GCM_192,
+	model.IPSecVpnIkeProfile_ENCRYPTION_ALGORITHMS_GCM_256,
+}
+
+var ipSecVpnIkeProfileIkeVersionValues = []string{
+	model.IPSecVpnIkeProfile_IKE_VERSION_V1,
+	model.IPSecVpnIkeProfile_IKE_VERSION_V2,
+	model.IPSecVpnIkeProfile_IKE_VERSION_FLEX,
+}
+
+func resourceNsxtPolicyIPSecVpnIkeProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIPSecVpnIkeProfileCreate,
+		Read:   resourceNsxtPolicyIPSecVpnIkeProfileRead,
+		Update: resourceNsxtPolicyIPSecVpnIkeProfileUpdate,
+		Delete: resourceNsxtPolicyIPSecVpnIkeProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"dh_groups": {
+				Type:        schema.TypeSet,
+				Description: "Diffie-Hellman groups to be used",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ipSecVpnIkeProfileDhGroupValues, false),
+				},
+			},
+			"digest_algorithms": {
+				Type:        schema.TypeSet,
+				Description: "Algorithms to be used for message digest during IKE negotiation",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ipSecVpnIkeProfileDigestAlgorithmValues, false),
+				},
+			},
+			"encryption_algorithms": {
+				Type:        schema.TypeSet,
+				Description: "Encryption algorithms to be used during IKE negotiation",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ipSecVpnIkeProfileEncryptionAlgorithmValues, false),
+				},
+			},
+			"ike_version": {
+				Type:         schema.TypeString,
+				Description:  "IKE protocol version to be used",
+				Optional:     true,
+				Default:      model.IPSecVpnIkeProfile_IKE_VERSION_V2,
+				ValidateFunc: validation.StringInSlice(ipSecVpnIkeProfileIkeVersionValues, false),
+			},
+			"sa_life_time": {
+				Type:         schema.TypeInt,
+				Description:  "Life time for security association (in seconds)",
+				Optional:     true,
+				Default:      86400,
+				ValidateFunc: validation.IntBetween(21600, 31536000),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIPSecVpnIkeProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewDefaultIpsecVpnIkeProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getIPSecVpnIkeProfileFromSchema(d *schema.ResourceData) model.IPSecVpnIkeProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	ikeVersion := d.Get("ike_version").(string)
+	saLifeTime := int64(d.Get("sa_life_time").(int))
+
+	return model.IPSecVpnIkeProfile{
+		DisplayName:          &displayName,
+		Description:          &description,
+		Tags:                 tags,
+		DhGroups:             getStringListFromSchemaSet(d, "dh_groups"),
+		DigestAlgorithms:     getStringListFromSchemaSet(d, "digest_algorithms"),
+		EncryptionAlgorithms: getStringListFromSchemaSet(d, "encryption_algorithms"),
+		IkeVersion:           &ikeVersion,
+		SaLifeTime:           &saLifeTime,
+	}
+}
+
+func resourceNsxtPolicyIPSecVpnIkeProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIPSecVpnIkeProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getIPSecVpnIkeProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating IPSecVpnIkeProfile with ID %s", id)
+	client := infra.NewDefaultIpsecVpnIkeProfilesClient(connector)
+	err = client.Patch(id, obj)
+	if err != nil {
+		return handleCreateError("IPSecVpnIkeProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIPSecVpnIkeProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIPSecVpnIkeProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSecVpnIkeProfile ID")
+	}
+
+	client := infra.NewDefaultIpsecVpnIkeProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "IPSecVpnIkeProfile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("dh_groups", obj.DhGroups)
+	d.Set("digest_algorithms", obj.DigestAlgorithms)
+	d.Set("encryption_algorithms", obj.EncryptionAlgorithms)
+	d.Set("ike_version", obj.IkeVersion)
+	d.Set("sa_life_time", obj.SaLifeTime)
+
+	return nil
+}
+
+func resourceNsxtPolicyIPSecVpnIkeProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSecVpnIkeProfile ID")
+	}
+
+	obj := getIPSecVpnIkeProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	log.Printf("[INFO] Updating IPSecVpnIkeProfile with ID %s", id)
+	client := infra.NewDefaultIpsecVpnIkeProfilesClient(connector)
+	_, err := client.Update(id, obj)
+	if err != nil {
+		return handleUpdateError("IPSecVpnIkeProfile", id, err)
+	}
+
+	return resourceNsxtPolicyIPSecVpnIkeProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIPSecVpnIkeProfileDelete(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSecVpnIkeProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDefaultIpsecVpnIkeProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("IPSecVpnIkeProfile", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIPSecVpnIkeProfileCreateAttributes = map[string]string{
+	"display_name":            getAccTestResourceName(),
+	"description":             "terraform created",
+	"dh_groups.#":             "1",
+	"digest_algorithms.#":     "1",
+	"encryption_algorithms.#": "1",
+	"ike_version":             "IKE_V2",
+	"sa_life_time":            "21600",
+}
+
+var accTestPolicyIPSecVpnIkeProfileUpdateAttributes = map[string]string{
+	"display_name":            getAccTestResourceName(),
+	"description":             "terraform updated",
+	"dh_groups.#":             "2",
+	"digest_algorithms.#":     "2",
+	"encryption_algorithms.#": "2",
+	"ike_version":             "IKE_FLEX",
+	"sa_life_time":            "43200",
+}
+
+func TestAccResourceNsxtPolicyIPSecVpnIkeProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipsec_vpn_ike_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnIkeProfileCheckDestroy(state, accTestPolicyIPSecVpnIkeProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnIkeProfileTemplate(true),
+				Check:  testAccNsxtPolicyIPSecVpnIkeProfileCheckAttributes(testResourceName, accTestPolicyIPSecVpnIkeProfileCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPSecVpnIkeProfileTemplate(false),
+				Check:  testAccNsxtPolicyIPSecVpnIkeProfileCheckAttributes(testResourceName, accTestPolicyIPSecVpnIkeProfileUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPSecVpnIkeProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIPSecVpnIkeProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "ike_version", "IKE_V2"),
+					resource.TestCheckResourceAttr(testResourceName, "sa_life_time", "86400"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIPSecVpnIkeProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_ipsec_vpn_ike_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnIkeProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnIkeProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIPSecVpnIkeProfileCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyIPSecVpnIkeProfileExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyIPSecVpnIkeProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyIPSecVpnIkeProfileExists)
+}
+
+func testAccNsxtPolicyIPSecVpnIkeProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_ipsec_vpn_ike_profile", resourceNsxtPolicyIPSecVpnIkeProfileExists)
+}
+
+func testAccNsxtPolicyIPSecVpnIkeProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	var dhGroups, digestAlgorithms, encryptionAlgorithms string
+	if createFlow {
+		attrMap = accTestPolicyIPSecVpnIkeProfileCreateAttributes
+		dhGroups = `["GROUP14"]`
+		digestAlgorithms = `["SHA2_256"]`
+		encryptionAlgorithms = `["AES_128"]`
+	} else {
+		attrMap = accTestPolicyIPSecVpnIkeProfileUpdateAttributes
+		dhGroups = `["GROUP14", "GROUP19"]`
+		digestAlgorithms = `["SHA2_256", "SHA2_512"]`
+		encryptionAlgorithms = `["AES_128", "AES_256"]`
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_ike_profile" "test" {
+  display_name          = "%s"
+  description           = "%s"
+  dh_groups             = %s
+  digest_algorithms     = %s
+  encryption_algorithms = %s
+  ike_version           = "%s"
+  sa_life_time          = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], dhGroups, digestAlgorithms, encryptionAlgorithms, attrMap["ike_version"], attrMap["sa_life_time"])
+}
+
+func testAccNsxtPolicyIPSecVpnIkeProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_ike_profile" "test" {
+  display_name          = "%s"
+  dh_groups             = ["GROUP14"]
+  encryption_algorithms = ["AES_GCM_128"]
+}`, accTestPolicyIPSecVpnIkeProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,279 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t0_ipsec_vpn_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/ipsec_vpn_services"
+	t1_ipsec_vpn_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services/ipsec_vpn_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const ipSecVpnServicesPathSegment = "ipsec-vpn-services"
+
+func resourceNsxtPolicyIPSecVpnLocalEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIPSecVpnLocalEndpointCreate,
+		Read:   resourceNsxtPolicyIPSecVpnLocalEndpointRead,
+		Update: resourceNsxtPolicyIPSecVpnLocalEndpointUpdate,
+		Delete: resourceNsxtPolicyIPSecVpnLocalEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyIPSecVpnLocalEndpointImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"service_path": getPolicyPathSchema(true, true, "Policy path of the IPSec VPN service for this local endpoint"),
+			"local_address": {
+				Type:         schema.TypeString,
+				Description:  "IPv4 address of the local endpoint",
+				Required:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"local_id": {
+				Type:        schema.TypeString,
+				Description: "Local identifier, defaults to local address",
+				Optional:    true,
+				Computed:    true,
+			},
+			"certificate_path": getPolicyPathSchema(false, false, "Policy path of the site certificate, required for certificate based authentication"),
+			"trust_ca_paths": {
+				Type:        schema.TypeSet,
+				Description: "Policy paths of certificate authority (CA) certificates used to verify peer certificates",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+			"trust_crl_paths": {
+				Type:        schema.TypeSet,
+				Description: "Policy paths of certificate revocation lists (CRL) used to verify peer certificates",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+		},
+	}
+}
+
+func getIPSecVpnServiceFromSchema(d *schema.ResourceData) (bool, string, string, string, error) {
+	servicePath := d.Get("service_path").(string)
+	isT0, gwID, localeServiceID, serviceID := parseVpnServicePolicyPath(servicePath, ipSecVpnServicesPathSegment)
+	if gwID == "" {
+		return false, "", "", "", fmt.Errorf("Expecting IPSec VPN service path, got %s", servicePath)
+	}
+
+	return isT0, gwID, localeServiceID, serviceID, nil
+}
+
+func resourceNsxtPolicyIPSecVpnLocalEndpointExists(isT0 bool, gwID string, localeServiceID string, serviceID string, id string, connector *client.RestConnector) (bool, error) {
+	var err error
+	if isT0 {
+		client := t0_ipsec_vpn_services.NewDefaultLocalEndpointsClient(connector)
+		_, err = client.Get(gwID, localeServiceID, serviceID, id)
+	} else {
+		client := t1_ipsec_vpn_services.NewDefaultLocalEndpointsClient(connector)
+		_, err = client.Get(gwID, localeServiceID, serviceID, id)
+	}
+
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func policyIPSecVpnLocalEndpointPatch(d *schema.ResourceData, m interface{}, isT0 bool, gwID string, localeServiceID string, serviceID string, id string) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	localAddress := d.Get("local_address").(string)
+	trustCaPaths := getStringListFromSchemaSet(d, "trust_ca_paths")
+	trustCrlPaths := getStringListFromSchemaSet(d, "trust_crl_paths")
+
+	obj := model.IPSecVpnLocalEndpoint{
+		DisplayName:   &displayName,
+		Description:   &description,
+		Tags:          tags,
+		LocalAddress:  &localAddress,
+		TrustCaPaths:  trustCaPaths,
+		TrustCrlPaths: trustCrlPaths,
+	}
+
+	localID := d.Get("local_id").(string)
+	if localID != "" {
+		obj.LocalId = &localID
+	}
+	certificatePath := d.Get("certificate_path").(string)
+	if certificatePath != "" {
+		obj.CertificatePath = &certificatePath
+	}
+
+	connector := getPolicyConnector(m)
+	if isT0 {
+		client := t0_ipsec_vpn_services.NewDefaultLocalEndpointsClient(connector)
+		return client.Patch(gwID, localeServiceID, serviceID, id, obj)
+	}
+
+	client := t1_ipsec_vpn_services.NewDefaultLocalEndpointsClient(connector)
+	return client.Patch(gwID, localeServiceID, serviceID, id, obj)
+}
+
+func resourceNsxtPolicyIPSecVpnLocalEndpointCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	isT0, gwID, localeServiceID, serviceID, err := getIPSecVpnServiceFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicyIPSecVpnLocalEndpointExists(isT0, gwID, localeServiceID, serviceID, id, getPolicyConnector(m))
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Resource with id %s already exists", id)
+		}
+	}
+
+	log.Printf("[INFO] Creating IPSecVpnLocalEndpoint with ID %s", id)
+	err = policyIPSecVpnLocalEndpointPatch(d, m, isT0, gwID, localeServiceID, serviceID, id)
+	if err != nil {
+		return handleCreateError("IPSecVpnLocalEndpoint", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIPSecVpnLocalEndpointRead(d, m)
+}
+
+func resourceNsxtPolicyIPSecVpnLocalEndpointRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
+	}
+
+	isT0, gwID, localeServiceID, serviceID, err := getIPSecVpnServiceFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	var obj model.IPSecVpnLocalEndpoint
+	if isT0 {
+		client := t0_ipsec_vpn_services.NewDefaultLocalEndpointsClient(connector)
+		obj, err = client.Get(gwID, localeServiceID, serviceID, id)
+	} else {
+		client := t1_ipsec_vpn_services.NewDefaultLocalEndpointsClient(connector)
+		obj, err = client.Get(gwID, localeServiceID, serviceID, id)
+	}
+	if err != nil {
+		return handleReadError(d, "IPSecVpnLocalEndpoint", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("local_address", obj.LocalAddress)
+	d.Set("local_id", obj.LocalId)
+	d.Set("certificate_path", obj.CertificatePath)
+	d.Set("trust_ca_paths", obj.TrustCaPaths)
+	d.Set("trust_crl_paths", obj.TrustCrlPaths)
+
+	return nil
+}
+
+func resourceNsxtPolicyIPSecVpnLocalEndpointUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
+	}
+
+	isT0, gwID, localeServiceID, serviceID, err := getIPSecVpnServiceFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	err = policyIPSecVpnLocalEndpointPatch(d, m, isT0, gwID, localeServiceID, serviceID, id)
+	if err != nil {
+		return handleUpdateError("IPSecVpnLocalEndpoint", id, err)
+	}
+
+	return resourceNsxtPolicyIPSecVpnLocalEndpointRead(d, m)
+}
+
+func resourceNsxtPolicyIPSecVpnLocalEndpointDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
+	}
+
+	isT0, gwID, localeServiceID, serviceID, err := getIPSecVpnServiceFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	if isT0 {
+		client := t0_ipsec_vpn_services.NewDefaultLocalEndpointsClient(connector)
+		err = client.Delete(gwID, localeServiceID, serviceID, id)
+	} else {
+		client := t1_ipsec_vpn_services.NewDefaultLocalEndpointsClient(connector)
+		err = client.Delete(gwID, localeServiceID, serviceID, id)
+	}
+	if err != nil {
+		return handleDeleteError("IPSecVpnLocalEndpoint", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyIPSecVpnLocalEndpointImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	servicePath, id, err := parseVpnServiceChildImportID(d.Id(), ipSecVpnServicesPathSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	isT0, gwID, localeServiceID, serviceID := parseVpnServicePolicyPath(servicePath, ipSecVpnServicesPathSegment)
+	exists, err := resourceNsxtPolicyIPSecVpnLocalEndpointExists(isT0, gwID, localeServiceID, serviceID, id, getPolicyConnector(m))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("IPSecVpnLocalEndpoint %s not found under %s", id, servicePath)
+	}
+
+	d.Set("service_path", servicePath)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
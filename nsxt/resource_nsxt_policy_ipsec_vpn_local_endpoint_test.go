@@ -0,0 +1,222 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIPSecVpnLocalEndpointCreateAttributes = map[string]string{
+	"display_name":  getAccTestResourceName(),
+	"description":   "terraform created",
+	"local_address": "20.20.0.10",
+	"local_id":      "test-create",
+}
+
+var accTestPolicyIPSecVpnLocalEndpointUpdateAttributes = map[string]string{
+	"display_name":  getAccTestResourceName(),
+	"description":   "terraform updated",
+	"local_address": "20.20.0.20",
+	"local_id":      "test-update",
+}
+
+func TestAccResourceNsxtPolicyIPSecVpnLocalEndpoint_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipsec_vpn_local_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnLocalEndpointCheckDestroy(state, accTestPolicyIPSecVpnLocalEndpointUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnLocalEndpointTemplate(true),
+				Check:  testAccNsxtPolicyIPSecVpnLocalEndpointCheckAttributes(testResourceName, accTestPolicyIPSecVpnLocalEndpointCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPSecVpnLocalEndpointTemplate(false),
+				Check:  testAccNsxtPolicyIPSecVpnLocalEndpointCheckAttributes(testResourceName, accTestPolicyIPSecVpnLocalEndpointUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPSecVpnLocalEndpointMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIPSecVpnLocalEndpointExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "local_address", "20.20.0.10"),
+					resource.TestCheckResourceAttrSet(testResourceName, "local_id"),
+					resource.TestCheckResourceAttr(testResourceName, "trust_ca_paths.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "trust_crl_paths.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "service_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIPSecVpnLocalEndpoint_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_ipsec_vpn_local_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnLocalEndpointCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnLocalEndpointMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNSXPolicyVpnServiceChildImporterGetID(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNSXPolicyVpnServiceChildImporterGetID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("NSX Policy resource %s not found in resources", resourceName)
+		}
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return "", fmt.Errorf("NSX Policy resource ID not set in resources ")
+		}
+		servicePath := rs.Primary.Attributes["service_path"]
+		if servicePath == "" {
+			return "", fmt.Errorf("NSX Policy resource service_path not set in resources ")
+		}
+		// Path looks like /infra/<tier-0s>/<gateway-id>/locale-services/<locale-service-id>/<vpn-services>/<service-id>
+		segs := strings.Split(servicePath, "/")
+		if len(segs) != 8 {
+			return "", fmt.Errorf("Unexpected service_path %s", servicePath)
+		}
+		return fmt.Sprintf("%s/%s/%s/%s/%s", segs[2], segs[3], segs[5], segs[7], resourceID), nil
+	}
+}
+
+func testAccNsxtPolicyIPSecVpnLocalEndpointCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyIPSecVpnLocalEndpointExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyIPSecVpnLocalEndpointExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy IPSec VPN Local Endpoint resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy IPSec VPN Local Endpoint resource ID not set in resources")
+		}
+
+		isT0, gwID, localeServiceID, serviceID := parseVpnServicePolicyPath(rs.Primary.Attributes["service_path"], ipSecVpnServicesPathSegment)
+		exists, err := resourceNsxtPolicyIPSecVpnLocalEndpointExists(isT0, gwID, localeServiceID, serviceID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("IPSec VPN Local Endpoint ID %s does not exist on backend", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyIPSecVpnLocalEndpointCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_ipsec_vpn_local_endpoint" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		isT0, gwID, localeServiceID, serviceID := parseVpnServicePolicyPath(rs.Primary.Attributes["service_path"], ipSecVpnServicesPathSegment)
+		exists, err := resourceNsxtPolicyIPSecVpnLocalEndpointExists(isT0, gwID, localeServiceID, serviceID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Policy IPSec VPN Local Endpoint %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyIPSecVpnLocalEndpointPrerequisites() string {
+	return testAccNsxtPolicyIPSecVpnServicePrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_service" "test" {
+  locale_service_path = "${nsxt_policy_tier0_gateway.test.path}/locale-services/default"
+  display_name        = "%s"
+}`, accTestPolicyIPSecVpnServiceHelperName)
+}
+
+func testAccNsxtPolicyIPSecVpnLocalEndpointTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyIPSecVpnLocalEndpointCreateAttributes
+	} else {
+		attrMap = accTestPolicyIPSecVpnLocalEndpointUpdateAttributes
+	}
+	return testAccNsxtPolicyIPSecVpnLocalEndpointPrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_local_endpoint" "test" {
+  service_path = nsxt_policy_ipsec_vpn_service.test.path
+
+  display_name  = "%s"
+  description   = "%s"
+  local_address = "%s"
+  local_id      = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["local_address"], attrMap["local_id"])
+}
+
+func testAccNsxtPolicyIPSecVpnLocalEndpointMinimalistic() string {
+	return testAccNsxtPolicyIPSecVpnLocalEndpointPrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_local_endpoint" "test" {
+  service_path  = nsxt_policy_ipsec_vpn_service.test.path
+  display_name  = "%s"
+  local_address = "20.20.0.10"
+}`, accTestPolicyIPSecVpnLocalEndpointUpdateAttributes["display_name"])
+}
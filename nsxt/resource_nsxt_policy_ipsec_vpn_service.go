@@ -0,0 +1,299 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t0_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
+	t1_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var ipSecVpnServiceIkeLogLevelValues = []string{
+	model.IPSecVpnService_IKE_LOG_LEVEL_DEBUG,
+	model.IPSecVpnService_IKE_LOG_LEVEL_INFO,
+	model.IPSecVpnService_IKE_LOG_LEVEL_WARN,
+	model.IPSecVpnService_IKE_LOG_LEVEL_ERROR,
+	model.IPSecVpnService_IKE_LOG_LEVEL_EMERGENCY,
+}
+
+func resourceNsxtPolicyIPSecVpnService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIPSecVpnServiceCreate,
+		Read:   resourceNsxtPolicyIPSecVpnServiceRead,
+		Update: resourceNsxtPolicyIPSecVpnServiceUpdate,
+		Delete: resourceNsxtPolicyIPSecVpnServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyIPSecVpnServiceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":              getNsxIDSchema(),
+			"path":                getPathSchema(),
+			"display_name":        getDisplayNameSchema(),
+			"description":         getDescriptionSchema(),
+			"revision":            getRevisionSchema(),
+			"tag":                 getTagsSchema(),
+			"locale_service_path": getPolicyPathSchema(true, true, "Policy path of the gateway locale service for this VPN service"),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable IPSec VPN service",
+				Optional:    true,
+				Default:     true,
+			},
+			"ha_sync": {
+				Type:        schema.TypeBool,
+				Description: "Enable state synchronization of VPN sessions between active and standby edges",
+				Optional:    true,
+				Default:     true,
+			},
+			"ike_log_level": {
+				Type:         schema.TypeString,
+				Description:  "Log level for internet key exchange (IKE)",
+				Optional:     true,
+				Default:      model.IPSecVpnService_IKE_LOG_LEVEL_INFO,
+				ValidateFunc: validation.StringInSlice(ipSecVpnServiceIkeLogLevelValues, false),
+			},
+		},
+	}
+}
+
+func parseLocaleServicePolicyPath(path string) (bool, string, string) {
+	// sample path looks like "/infra/tier-0s/mytier0gw/locale-services/default"
+	segs := strings.Split(path, "/")
+	if len(segs) != 6 || segs[4] != "locale-services" {
+		return false, "", ""
+	}
+	if segs[2] != "tier-0s" && segs[2] != "tier-1s" {
+		return false, "", ""
+	}
+
+	return segs[2] == "tier-0s", segs[3], segs[5]
+}
+
+func parseVpnServicePolicyPath(path string, servicesSegment string) (bool, string, string, string) {
+	// sample path looks like "/infra/tier-0s/mytier0gw/locale-services/default/ipsec-vpn-services/myvpn"
+	segs := strings.Split(path, "/")
+	if len(segs) != 8 || segs[6] != servicesSegment {
+		return false, "", "", ""
+	}
+	isT0, gwID, localeServiceID := parseLocaleServicePolicyPath(strings.Join(segs[:6], "/"))
+	if gwID == "" {
+		return false, "", "", ""
+	}
+
+	return isT0, gwID, localeServiceID, segs[7]
+}
+
+// parseVpnServiceChildImportID converts import ID of an object under VPN service to service path and object ID
+func parseVpnServiceChildImportID(importID string, servicesSegment string) (string, string, error) {
+	s := strings.Split(importID, "/")
+	if len(s) != 5 || (s[0] != "tier-0s" && s[0] != "tier-1s") {
+		return "", "", fmt.Errorf("Please provide <tier-0s|tier-1s>/<gateway-id>/<locale-service-id>/<service-id>/<id> as an input")
+	}
+
+	servicePath := fmt.Sprintf("/infra/%s/%s/locale-services/%s/%s/%s", s[0], s[1], s[2], servicesSegment, s[3])
+	return servicePath, s[4], nil
+}
+
+func resourceNsxtPolicyIPSecVpnServiceExists(isT0 bool, gwID string, localeServiceID string, id string, connector *client.RestConnector) (bool, error) {
+	var err error
+	if isT0 {
+		client := t0_locale_services.NewDefaultIpsecVpnServicesClient(connector)
+		_, err = client.Get(gwID, localeServiceID, id)
+	} else {
+		client := t1_locale_services.NewDefaultIpsecVpnServicesClient(connector)
+		_, err = client.Get(gwID, localeServiceID, id)
+	}
+
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func policyIPSecVpnServicePatch(d *schema.ResourceData, m interface{}, isT0 bool, gwID string, localeServiceID string, id string) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	enabled := d.Get("enabled").(bool)
+	haSync := d.Get("ha_sync").(bool)
+	ikeLogLevel := d.Get("ike_log_level").(string)
+
+	obj := model.IPSecVpnService{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		Enabled:     &enabled,
+		HaSync:      &haSync,
+		IkeLogLevel: &ikeLogLevel,
+	}
+
+	connector := getPolicyConnector(m)
+	if isT0 {
+		client := t0_locale_services.NewDefaultIpsecVpnServicesClient(connector)
+		return client.Patch(gwID, localeServiceID, id, obj)
+	}
+
+	client := t1_locale_services.NewDefaultIpsecVpnServicesClient(connector)
+	return client.Patch(gwID, localeServiceID, id, obj)
+}
+
+func resourceNsxtPolicyIPSecVpnServiceCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	localeServicePath := d.Get("locale_service_path").(string)
+	isT0, gwID, localeServiceID := parseLocaleServicePolicyPath(localeServicePath)
+	if gwID == "" {
+		return fmt.Errorf("Expecting gateway locale service path, got %s", localeServicePath)
+	}
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicyIPSecVpnServiceExists(isT0, gwID, localeServiceID, id, getPolicyConnector(m))
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Resource with id %s already exists", id)
+		}
+	}
+
+	log.Printf("[INFO] Creating IPSecVpnService with ID %s", id)
+	err := policyIPSecVpnServicePatch(d, m, isT0, gwID, localeServiceID, id)
+	if err != nil {
+		return handleCreateError("IPSecVpnService", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIPSecVpnServiceRead(d, m)
+}
+
+func resourceNsxtPolicyIPSecVpnServiceRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSecVpnService ID")
+	}
+
+	localeServicePath := d.Get("locale_service_path").(string)
+	isT0, gwID, localeServiceID := parseLocaleServicePolicyPath(localeServicePath)
+	if gwID == "" {
+		return fmt.Errorf("Expecting gateway locale service path, got %s", localeServicePath)
+	}
+
+	var obj model.IPSecVpnService
+	var err error
+	if isT0 {
+		client := t0_locale_services.NewDefaultIpsecVpnServicesClient(connector)
+		obj, err = client.Get(gwID, localeServiceID, id)
+	} else {
+		client := t1_locale_services.NewDefaultIpsecVpnServicesClient(connector)
+		obj, err = client.Get(gwID, localeServiceID, id)
+	}
+	if err != nil {
+		return handleReadError(d, "IPSecVpnService", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("enabled", obj.Enabled)
+	d.Set("ha_sync", obj.HaSync)
+	d.Set("ike_log_level", obj.IkeLogLevel)
+
+	return nil
+}
+
+func resourceNsxtPolicyIPSecVpnServiceUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSecVpnService ID")
+	}
+
+	localeServicePath := d.Get("locale_service_path").(string)
+	isT0, gwID, localeServiceID := parseLocaleServicePolicyPath(localeServicePath)
+	if gwID == "" {
+		return fmt.Errorf("Expecting gateway locale service path, got %s", localeServicePath)
+	}
+
+	err := policyIPSecVpnServicePatch(d, m, isT0, gwID, localeServiceID, id)
+	if err != nil {
+		return handleUpdateError("IPSecVpnService", id, err)
+	}
+
+	return resourceNsxtPolicyIPSecVpnServiceRead(d, m)
+}
+
+func resourceNsxtPolicyIPSecVpnServiceDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSecVpnService ID")
+	}
+
+	localeServicePath := d.Get("locale_service_path").(string)
+	isT0, gwID, localeServiceID := parseLocaleServicePolicyPath(localeServicePath)
+	if gwID == "" {
+		return fmt.Errorf("Expecting gateway locale service path, got %s", localeServicePath)
+	}
+
+	connector := getPolicyConnector(m)
+	var err error
+	if isT0 {
+		client := t0_locale_services.NewDefaultIpsecVpnServicesClient(connector)
+		err = client.Delete(gwID, localeServiceID, id)
+	} else {
+		client := t1_locale_services.NewDefaultIpsecVpnServicesClient(connector)
+		err = client.Delete(gwID, localeServiceID, id)
+	}
+	if err != nil {
+		return handleDeleteError("IPSecVpnService", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyIPSecVpnServiceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	s := strings.Split(importID, "/")
+	if len(s) != 4 || (s[0] != "tier-0s" && s[0] != "tier-1s") {
+		return nil, fmt.Errorf("Please provide <tier-0s|tier-1s>/<gateway-id>/<locale-service-id>/<service-id> as an input")
+	}
+
+	localeServicePath := fmt.Sprintf("/infra/%s/%s/locale-services/%s", s[0], s[1], s[2])
+	exists, err := resourceNsxtPolicyIPSecVpnServiceExists(s[0] == "tier-0s", s[1], s[2], s[3], getPolicyConnector(m))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("IPSecVpnService %s not found under %s", s[3], localeServicePath)
+	}
+
+	d.Set("locale_service_path", localeServicePath)
+	d.SetId(s[3])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,228 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIPSecVpnServiceCreateAttributes = map[string]string{
+	"display_name":  getAccTestResourceName(),
+	"description":   "terraform created",
+	"enabled":       "true",
+	"ha_sync":       "true",
+	"ike_log_level": "INFO",
+}
+
+var accTestPolicyIPSecVpnServiceUpdateAttributes = map[string]string{
+	"display_name":  getAccTestResourceName(),
+	"description":   "terraform updated",
+	"enabled":       "false",
+	"ha_sync":       "false",
+	"ike_log_level": "ERROR",
+}
+
+var accTestPolicyIPSecVpnServiceHelperName = getAccTestResourceName()
+
+func TestAccResourceNsxtPolicyIPSecVpnService_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipsec_vpn_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnServiceCheckDestroy(state, accTestPolicyIPSecVpnServiceUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnServiceTemplate(true),
+				Check:  testAccNsxtPolicyIPSecVpnServiceCheckAttributes(testResourceName, accTestPolicyIPSecVpnServiceCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPSecVpnServiceTemplate(false),
+				Check:  testAccNsxtPolicyIPSecVpnServiceCheckAttributes(testResourceName, accTestPolicyIPSecVpnServiceUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPSecVpnServiceMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIPSecVpnServiceExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "ha_sync", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "ike_log_level", "INFO"),
+					resource.TestCheckResourceAttrSet(testResourceName, "locale_service_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIPSecVpnService_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_ipsec_vpn_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnServiceCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnServiceMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNSXPolicyIPSecVpnServiceImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNSXPolicyIPSecVpnServiceImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_ipsec_vpn_service.test"]
+	if !ok {
+		return "", fmt.Errorf("NSX Policy IPSec VPN Service resource %s not found in resources", "nsxt_policy_ipsec_vpn_service.test")
+	}
+	resourceID := rs.Primary.ID
+	if resourceID == "" {
+		return "", fmt.Errorf("NSX Policy IPSec VPN Service resource ID not set in resources ")
+	}
+	localeServicePath := rs.Primary.Attributes["locale_service_path"]
+	if localeServicePath == "" {
+		return "", fmt.Errorf("NSX Policy IPSec VPN Service locale_service_path not set in resources ")
+	}
+	// Strip the /infra/ prefix to get <tier-0s>/<gateway-id>/locale-services/<locale-service-id>
+	segs := strings.Split(localeServicePath, "/")
+	if len(segs) != 6 {
+		return "", fmt.Errorf("Unexpected locale_service_path %s", localeServicePath)
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", segs[2], segs[3], segs[5], resourceID), nil
+}
+
+func testAccNsxtPolicyIPSecVpnServiceCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyIPSecVpnServiceExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyIPSecVpnServiceExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy IPSec VPN Service resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy IPSec VPN Service resource ID not set in resources")
+		}
+
+		isT0, gwID, localeServiceID := parseLocaleServicePolicyPath(rs.Primary.Attributes["locale_service_path"])
+		exists, err := resourceNsxtPolicyIPSecVpnServiceExists(isT0, gwID, localeServiceID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("IPSec VPN Service ID %s does not exist on backend", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyIPSecVpnServiceCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_ipsec_vpn_service" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		isT0, gwID, localeServiceID := parseLocaleServicePolicyPath(rs.Primary.Attributes["locale_service_path"])
+		exists, err := resourceNsxtPolicyIPSecVpnServiceExists(isT0, gwID, localeServiceID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Policy IPSec VPN Service %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyIPSecVpnServicePrerequisites() string {
+	return fmt.Sprintf(`
+data "nsxt_policy_edge_cluster" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name      = "%s"
+  ha_mode           = "ACTIVE_STANDBY"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+}`, getEdgeClusterName(), accTestPolicyIPSecVpnServiceHelperName)
+}
+
+func testAccNsxtPolicyIPSecVpnServiceTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyIPSecVpnServiceCreateAttributes
+	} else {
+		attrMap = accTestPolicyIPSecVpnServiceUpdateAttributes
+	}
+	return testAccNsxtPolicyIPSecVpnServicePrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_service" "test" {
+  locale_service_path = "${nsxt_policy_tier0_gateway.test.path}/locale-services/default"
+
+  display_name  = "%s"
+  description   = "%s"
+  enabled       = %s
+  ha_sync       = %s
+  ike_log_level = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["enabled"], attrMap["ha_sync"], attrMap["ike_log_level"])
+}
+
+func testAccNsxtPolicyIPSecVpnServiceMinimalistic() string {
+	return testAccNsxtPolicyIPSecVpnServicePrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_service" "test" {
+  locale_service_path = "${nsxt_policy_tier0_gateway.test.path}/locale-services/default"
+  display_name        = "%s"
+}`, accTestPolicyIPSecVpnServiceUpdateAttributes["display_name"])
+}
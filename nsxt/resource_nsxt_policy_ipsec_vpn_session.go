@@ -0,0 +1,655 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t0_ipsec_vpn_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/ipsec_vpn_services"
+	t1_ipsec_vpn_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services/ipsec_vpn_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const (
+	ipSecVpnSessionTypeRouteBased  = "RouteBased"
+	ipSecVpnSessionTypePolicyBased = "PolicyBased"
+)
+
+var ipSecVpnSessionTypeValues = []string{
+	ipSecVpnSessionTypeRouteBased,
+	ipSecVpnSessionTypePolicyBased,
+}
+
+var ipSecVpnSessionAuthenticationModeValues = []string{
+	model.IPSecVpnSession_AUTHENTICATION_MODE_PSK,
+	model.IPSecVpnSession_AUTHENTICATION_MODE_CERTIFICATE,
+}
+
+var ipSecVpnSessionComplianceSuiteValues = []string{
+	model.IPSecVpnSession_COMPLIANCE_SUITE_CNSA,
+	model.IPSecVpnSession_COMPLIANCE_SUITE_SUITE_B_GCM_128,
+	model.IPSecVpnSession_COMPLIANCE_SUITE_SUITE_B_GCM_256,
+	model.IPSecVpnSession_COMPLIANCE_SUITE_PRIME,
+	model.IPSecVpnSession_COMPLIANCE_SUITE_FOUNDATION,
+	model.IPSecVpnSession_COMPLIANCE_SUITE_FIPS,
+	model.IPSecVpnSession_COMPLIANCE_SUITE_NONE,
+}
+
+var ipSecVpnSessionConnectionInitiationModeValues = []string{
+	model.IPSecVpnSession_CONNECTION_INITIATION_MODE_INITIATOR,
+	model.IPSecVpnSession_CONNECTION_INITIATION_MODE_RESPOND_ONLY,
+	model.IPSecVpnSession_CONNECTION_INITIATION_MODE_ON_DEMAND,
+}
+
+var ipSecVpnRuleActionValues = []string{
+	model.IPSecVpnRule_ACTION_PROTECT,
+	model.IPSecVpnRule_ACTION_BYPASS,
+}
+
+var tcpMssClampingDirectionValues = []string{
+	model.TcpMaximumSegmentSizeClamping_DIRECTION_NONE,
+	model.TcpMaximumSegmentSizeClamping_DIRECTION_INBOUND_CONNECTION,
+	model.TcpMaximumSegmentSizeClamping_DIRECTION_OUTBOUND_CONNECTION,
+	model.TcpMaximumSegmentSizeClamping_DIRECTION_BOTH,
+}
+
+func resourceNsxtPolicyIPSecVpnSession() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIPSecVpnSessionCreate,
+		Read:   resourceNsxtPolicyIPSecVpnSessionRead,
+		Update: resourceNsxtPolicyIPSecVpnSessionUpdate,
+		Delete: resourceNsxtPolicyIPSecVpnSessionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyIPSecVpnSessionImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"service_path": getPolicyPathSchema(true, true, "Policy path of the IPSec VPN service for this session"),
+			"vpn_type": {
+				Type:         schema.TypeString,
+				Description:  "Type of the IPSec VPN session",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ipSecVpnSessionTypeRouteBased,
+				ValidateFunc: validation.StringInSlice(ipSecVpnSessionTypeValues, false),
+			},
+			"local_endpoint_path": getPolicyPathSchema(true, false, "Policy path of the local endpoint"),
+			"ike_profile_path":    getComputedPolicyPathSchema("Policy path of the IKE profile"),
+			"tunnel_profile_path": getComputedPolicyPathSchema("Policy path of the tunnel profile"),
+			"dpd_profile_path":    getComputedPolicyPathSchema("Policy path of the dead peer detection (DPD) profile"),
+			"authentication_mode": {
+				Type:         schema.TypeString,
+				Description:  "Peer authentication mode",
+				Optional:     true,
+				Default:      model.IPSecVpnSession_AUTHENTICATION_MODE_PSK,
+				ValidateFunc: validation.StringInSlice(ipSecVpnSessionAuthenticationModeValues, false),
+			},
+			"compliance_suite": {
+				Type:         schema.TypeString,
+				Description:  "Compliance suite that enforces a predefined set of cryptographic parameters",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ipSecVpnSessionComplianceSuiteValues, false),
+			},
+			"connection_initiation_mode": {
+				Type:         schema.TypeString,
+				Description:  "Connection initiation mode used by local endpoint to establish IKE connection with peer",
+				Optional:     true,
+				Default:      model.IPSecVpnSession_CONNECTION_INITIATION_MODE_INITIATOR,
+				ValidateFunc: validation.StringInSlice(ipSecVpnSessionConnectionInitiationModeValues, false),
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable IPSec VPN session",
+				Optional:    true,
+				Default:     true,
+			},
+			"peer_address": {
+				Type:        schema.TypeString,
+				Description: "Public IPv4 address of the remote device terminating the VPN connection",
+				Required:    true,
+			},
+			"peer_id": {
+				Type:        schema.TypeString,
+				Description: "Peer identifier",
+				Required:    true,
+			},
+			"psk": {
+				Type:        schema.TypeString,
+				Description: "Pre-shared key, required for PSK authentication mode",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"tcp_mss_clamping": getTcpMssClampingSchema(),
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Description: "IPv4 addresses of the tunnel interface, applicable to route based sessions",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateSingleIP(),
+				},
+			},
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Description:  "Subnet prefix length of the tunnel interface, applicable to route based sessions",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 31),
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "Rules for policy based sessions",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nsx_id": getComputedNsxIDSchema(),
+						"sources": {
+							Type:        schema.TypeSet,
+							Description: "Source subnets",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateCidr(),
+							},
+						},
+						"destinations": {
+							Type:        schema.TypeSet,
+							Description: "Destination subnets",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateCidr(),
+							},
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Description:  "Action to apply to the traffic matching the rule",
+							Optional:     true,
+							Default:      model.IPSecVpnRule_ACTION_PROTECT,
+							ValidateFunc: validation.StringInSlice(ipSecVpnRuleActionValues, false),
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Enable the rule",
+							Optional:    true,
+							Default:     true,
+						},
+						"logged": {
+							Type:        schema.TypeBool,
+							Description: "Enable logging for the rule",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getTcpMssClampingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "TCP maximum segment size (MSS) clamping",
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"direction": {
+					Type:         schema.TypeString,
+					Description:  "Direction of traffic on which MSS clamping is applied",
+					Optional:     true,
+					Default:      model.TcpMaximumSegmentSizeClamping_DIRECTION_NONE,
+					ValidateFunc: validation.StringInSlice(tcpMssClampingDirectionValues, false),
+				},
+				"max_segment_size": {
+					Type:         schema.TypeInt,
+					Description:  "Maximum segment size, calculated automatically if not set",
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(108, 8860),
+				},
+			},
+		},
+	}
+}
+
+func getTcpMssClampingFromSchema(d *schema.ResourceData) *model.TcpMaximumSegmentSizeClamping {
+	clampings := d.Get("tcp_mss_clamping").([]interface{})
+	if len(clampings) == 0 || clampings[0] == nil {
+		return nil
+	}
+
+	clamping := clampings[0].(map[string]interface{})
+	direction := clamping["direction"].(string)
+	obj := model.TcpMaximumSegmentSizeClamping{
+		Direction: &direction,
+	}
+	maxSegmentSize := int64(clamping["max_segment_size"].(int))
+	if maxSegmentSize > 0 {
+		obj.MaxSegmentSize = &maxSegmentSize
+	}
+
+	return &obj
+}
+
+func setTcpMssClampingInSchema(d *schema.ResourceData, obj *model.TcpMaximumSegmentSizeClamping) error {
+	var clampings []map[string]interface{}
+	if obj != nil {
+		elem := make(map[string]interface{})
+		elem["direction"] = obj.Direction
+		elem["max_segment_size"] = obj.MaxSegmentSize
+		clampings = append(clampings, elem)
+	}
+
+	return d.Set("tcp_mss_clamping", clampings)
+}
+
+func getIPSecVpnSubnetsFromSet(subnets *schema.Set) []model.IPSecVpnSubnet {
+	var result []model.IPSecVpnSubnet
+	for _, subnet := range subnets.List() {
+		cidr := subnet.(string)
+		result = append(result, model.IPSecVpnSubnet{Subnet: &cidr})
+	}
+
+	return result
+}
+
+func getIPSecVpnSubnetsList(subnets []model.IPSecVpnSubnet) []string {
+	var result []string
+	for _, subnet := range subnets {
+		if subnet.Subnet != nil {
+			result = append(result, *subnet.Subnet)
+		}
+	}
+
+	return result
+}
+
+func getIPSecVpnRulesFromSchema(d *schema.ResourceData) []model.IPSecVpnRule {
+	var rules []model.IPSecVpnRule
+	for i, rule := range d.Get("rule").([]interface{}) {
+		ruleCfg := rule.(map[string]interface{})
+		id := ruleCfg["nsx_id"].(string)
+		if id == "" {
+			id = newUUID()
+		}
+		action := ruleCfg["action"].(string)
+		enabled := ruleCfg["enabled"].(bool)
+		logged := ruleCfg["logged"].(bool)
+		sequenceNumber := int64(i)
+		rules = append(rules, model.IPSecVpnRule{
+			Id:             &id,
+			Sources:        getIPSecVpnSubnetsFromSet(ruleCfg["sources"].(*schema.Set)),
+			Destinations:   getIPSecVpnSubnetsFromSet(ruleCfg["destinations"].(*schema.Set)),
+			Action:         &action,
+			Enabled:        &enabled,
+			Logged:         &logged,
+			SequenceNumber: &sequenceNumber,
+		})
+	}
+
+	return rules
+}
+
+func setIPSecVpnRulesInSchema(d *schema.ResourceData, rules []model.IPSecVpnRule) error {
+	var rulesList []map[string]interface{}
+	for _, rule := range rules {
+		elem := make(map[string]interface{})
+		elem["nsx_id"] = rule.Id
+		elem["sources"] = getIPSecVpnSubnetsList(rule.Sources)
+		elem["destinations"] = getIPSecVpnSubnetsList(rule.Destinations)
+		elem["action"] = rule.Action
+		elem["enabled"] = rule.Enabled
+		elem["logged"] = rule.Logged
+		rulesList = append(rulesList, elem)
+	}
+
+	return d.Set("rule", rulesList)
+}
+
+func getIPSecVpnSessionValueFromSchema(d *schema.ResourceData) (*data.StructValue, error) {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	localEndpointPath := d.Get("local_endpoint_path").(string)
+	authenticationMode := d.Get("authentication_mode").(string)
+	connectionInitiationMode := d.Get("connection_initiation_mode").(string)
+	enabled := d.Get("enabled").(bool)
+	peerAddress := d.Get("peer_address").(string)
+	peerID := d.Get("peer_id").(string)
+	tcpMssClamping := getTcpMssClampingFromSchema(d)
+
+	var ikeProfilePath, tunnelProfilePath, dpdProfilePath, complianceSuite, psk *string
+	if value := d.Get("ike_profile_path").(string); value != "" {
+		ikeProfilePath = &value
+	}
+	if value := d.Get("tunnel_profile_path").(string); value != "" {
+		tunnelProfilePath = &value
+	}
+	if value := d.Get("dpd_profile_path").(string); value != "" {
+		dpdProfilePath = &value
+	}
+	if value := d.Get("compliance_suite").(string); value != "" {
+		complianceSuite = &value
+	}
+	if value := d.Get("psk").(string); value != "" {
+		psk = &value
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	var dataValue data.DataValue
+	var errs []error
+	if d.Get("vpn_type").(string) == ipSecVpnSessionTypePolicyBased {
+		obj := model.PolicyBasedIPSecVpnSession{
+			DisplayName:              &displayName,
+			Description:              &description,
+			Tags:                     tags,
+			ResourceType:             model.IPSecVpnSession_RESOURCE_TYPE_POLICYBASEDIPSECVPNSESSION,
+			LocalEndpointPath:        &localEndpointPath,
+			IkeProfilePath:           ikeProfilePath,
+			TunnelProfilePath:        tunnelProfilePath,
+			DpdProfilePath:           dpdProfilePath,
+			AuthenticationMode:       &authenticationMode,
+			ComplianceSuite:          complianceSuite,
+			ConnectionInitiationMode: &connectionInitiationMode,
+			Enabled:                  &enabled,
+			PeerAddress:              &peerAddress,
+			PeerId:                   &peerID,
+			Psk:                      psk,
+			TcpMssClamping:           tcpMssClamping,
+			Rules:                    getIPSecVpnRulesFromSchema(d),
+		}
+		dataValue, errs = converter.ConvertToVapi(obj, model.PolicyBasedIPSecVpnSessionBindingType())
+	} else {
+		obj := model.RouteBasedIPSecVpnSession{
+			DisplayName:              &displayName,
+			Description:              &description,
+			Tags:                     tags,
+			ResourceType:             model.IPSecVpnSession_RESOURCE_TYPE_ROUTEBASEDIPSECVPNSESSION,
+			LocalEndpointPath:        &localEndpointPath,
+			IkeProfilePath:           ikeProfilePath,
+			TunnelProfilePath:        tunnelProfilePath,
+			DpdProfilePath:           dpdProfilePath,
+			AuthenticationMode:       &authenticationMode,
+			ComplianceSuite:          complianceSuite,
+			ConnectionInitiationMode: &connectionInitiationMode,
+			Enabled:                  &enabled,
+			PeerAddress:              &peerAddress,
+			PeerId:                   &peerID,
+			Psk:                      psk,
+			TcpMssClamping:           tcpMssClamping,
+		}
+		ipAddresses := interface2StringList(d.Get("ip_addresses").([]interface{}))
+		if len(ipAddresses) > 0 {
+			prefixLength := int64(d.Get("prefix_length").(int))
+			obj.TunnelInterfaces = []model.IPSecVpnTunnelInterface{
+				{
+					IpSubnets: []model.TunnelInterfaceIPSubnet{
+						{
+							IpAddresses:  ipAddresses,
+							PrefixLength: &prefixLength,
+						},
+					},
+				},
+			}
+		}
+		dataValue, errs = converter.ConvertToVapi(obj, model.RouteBasedIPSecVpnSessionBindingType())
+	}
+	if errs != nil {
+		return nil, errs[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func getNsxtPolicyIPSecVpnSession(connector *client.RestConnector, isT0 bool, gwID string, localeServiceID string, serviceID string, id string) (*data.StructValue, error) {
+	if isT0 {
+		client := t0_ipsec_vpn_services.NewDefaultSessionsClient(connector)
+		return client.Get(gwID, localeServiceID, serviceID, id)
+	}
+
+	client := t1_ipsec_vpn_services.NewDefaultSessionsClient(connector)
+	return client.Get(gwID, localeServiceID, serviceID, id)
+}
+
+func resourceNsxtPolicyIPSecVpnSessionExists(isT0 bool, gwID string, localeServiceID string, serviceID string, id string, connector *client.RestConnector) (bool, error) {
+	_, err := getNsxtPolicyIPSecVpnSession(connector, isT0, gwID, localeServiceID, serviceID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func policyIPSecVpnSessionPatch(d *schema.ResourceData, m interface{}, isT0 bool, gwID string, localeServiceID string, serviceID string, id string) error {
+	obj, err := getIPSecVpnSessionValueFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	if isT0 {
+		client := t0_ipsec_vpn_services.NewDefaultSessionsClient(connector)
+		return client.Patch(gwID, localeServiceID, serviceID, id, obj)
+	}
+
+	client := t1_ipsec_vpn_services.NewDefaultSessionsClient(connector)
+	return client.Patch(gwID, localeServiceID, serviceID, id, obj)
+}
+
+func resourceNsxtPolicyIPSecVpnSessionCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	isT0, gwID, localeServiceID, serviceID, err := getIPSecVpnServiceFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicyIPSecVpnSessionExists(isT0, gwID, localeServiceID, serviceID, id, getPolicyConnector(m))
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Resource with id %s already exists", id)
+		}
+	}
+
+	log.Printf("[INFO] Creating IPSecVpnSession with ID %s", id)
+	err = policyIPSecVpnSessionPatch(d, m, isT0, gwID, localeServiceID, serviceID, id)
+	if err != nil {
+		return handleCreateError("IPSecVpnSession", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIPSecVpnSessionRead(d, m)
+}
+
+func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSecVpnSession ID")
+	}
+
+	isT0, gwID, localeServiceID, serviceID, err := getIPSecVpnServiceFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	dataValue, err := getNsxtPolicyIPSecVpnSession(connector, isT0, gwID, localeServiceID, serviceID, id)
+	if err != nil {
+		return handleReadError(d, "IPSecVpnSession", id, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	base, errs := converter.ConvertToGolang(dataValue, model.IPSecVpnSessionBindingType())
+	if errs != nil {
+		return errs[0]
+	}
+
+	// Psk is not returned by NSX and is kept as configured
+	if base.(model.IPSecVpnSession).ResourceType == model.IPSecVpnSession_RESOURCE_TYPE_POLICYBASEDIPSECVPNSESSION {
+		rawObj, errs := converter.ConvertToGolang(dataValue, model.PolicyBasedIPSecVpnSessionBindingType())
+		if errs != nil {
+			return errs[0]
+		}
+		obj := rawObj.(model.PolicyBasedIPSecVpnSession)
+
+		d.Set("display_name", obj.DisplayName)
+		d.Set("description", obj.Description)
+		setPolicyTagsInSchema(d, obj.Tags)
+		d.Set("nsx_id", id)
+		d.Set("path", obj.Path)
+		d.Set("revision", obj.Revision)
+
+		d.Set("vpn_type", ipSecVpnSessionTypePolicyBased)
+		d.Set("local_endpoint_path", obj.LocalEndpointPath)
+		d.Set("ike_profile_path", obj.IkeProfilePath)
+		d.Set("tunnel_profile_path", obj.TunnelProfilePath)
+		d.Set("dpd_profile_path", obj.DpdProfilePath)
+		d.Set("authentication_mode", obj.AuthenticationMode)
+		d.Set("compliance_suite", obj.ComplianceSuite)
+		d.Set("connection_initiation_mode", obj.ConnectionInitiationMode)
+		d.Set("enabled", obj.Enabled)
+		d.Set("peer_address", obj.PeerAddress)
+		d.Set("peer_id", obj.PeerId)
+		err = setTcpMssClampingInSchema(d, obj.TcpMssClamping)
+		if err != nil {
+			return err
+		}
+		return setIPSecVpnRulesInSchema(d, obj.Rules)
+	}
+
+	rawObj, errs := converter.ConvertToGolang(dataValue, model.RouteBasedIPSecVpnSessionBindingType())
+	if errs != nil {
+		return errs[0]
+	}
+	obj := rawObj.(model.RouteBasedIPSecVpnSession)
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("vpn_type", ipSecVpnSessionTypeRouteBased)
+	d.Set("local_endpoint_path", obj.LocalEndpointPath)
+	d.Set("ike_profile_path", obj.IkeProfilePath)
+	d.Set("tunnel_profile_path", obj.TunnelProfilePath)
+	d.Set("dpd_profile_path", obj.DpdProfilePath)
+	d.Set("authentication_mode", obj.AuthenticationMode)
+	d.Set("compliance_suite", obj.ComplianceSuite)
+	d.Set("connection_initiation_mode", obj.ConnectionInitiationMode)
+	d.Set("enabled", obj.Enabled)
+	d.Set("peer_address", obj.PeerAddress)
+	d.Set("peer_id", obj.PeerId)
+	err = setTcpMssClampingInSchema(d, obj.TcpMssClamping)
+	if err != nil {
+		return err
+	}
+	if len(obj.TunnelInterfaces) > 0 && len(obj.TunnelInterfaces[0].IpSubnets) > 0 {
+		subnet := obj.TunnelInterfaces[0].IpSubnets[0]
+		d.Set("ip_addresses", subnet.IpAddresses)
+		d.Set("prefix_length", subnet.PrefixLength)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyIPSecVpnSessionUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSecVpnSession ID")
+	}
+
+	isT0, gwID, localeServiceID, serviceID, err := getIPSecVpnServiceFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	err = policyIPSecVpnSessionPatch(d, m, isT0, gwID, localeServiceID, serviceID, id)
+	if err != nil {
+		return handleUpdateError("IPSecVpnSession", id, err)
+	}
+
+	return resourceNsxtPolicyIPSecVpnSessionRead(d, m)
+}
+
+func resourceNsxtPolicyIPSecVpnSessionDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSecVpnSession ID")
+	}
+
+	isT0, gwID, localeServiceID, serviceID, err := getIPSecVpnServiceFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	if isT0 {
+		client := t0_ipsec_vpn_services.NewDefaultSessionsClient(connector)
+		err = client.Delete(gwID, localeServiceID, serviceID, id)
+	} else {
+		client := t1_ipsec_vpn_services.NewDefaultSessionsClient(connector)
+		err = client.Delete(gwID, localeServiceID, serviceID, id)
+	}
+	if err != nil {
+		return handleDeleteError("IPSecVpnSession", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyIPSecVpnSessionImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	servicePath, id, err := parseVpnServiceChildImportID(d.Id(), ipSecVpnServicesPathSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	isT0, gwID, localeServiceID, serviceID := parseVpnServicePolicyPath(servicePath, ipSecVpnServicesPathSegment)
+	exists, err := resourceNsxtPolicyIPSecVpnSessionExists(isT0, gwID, localeServiceID, serviceID, id, getPolicyConnector(m))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("IPSecVpnSession %s not found under %s", id, servicePath)
+	}
+
+	d.Set("service_path", servicePath)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
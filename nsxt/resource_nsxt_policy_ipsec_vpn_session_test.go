@@ -0,0 +1,363 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIPSecVpnSessionCreateAttributes = map[string]string{
+	"display_name":                 getAccTestResourceName(),
+	"description":                  "terraform created",
+	"connection_initiation_mode":   "INITIATOR",
+	"enabled":                      "true",
+	"peer_address":                 "30.30.0.10",
+	"peer_id":                      "30.30.0.10",
+	"prefix_length":                "24",
+	"ip_addresses.0":               "169.254.152.2",
+	"tcp_mss_clamping.0.direction": "NONE",
+}
+
+var accTestPolicyIPSecVpnSessionUpdateAttributes = map[string]string{
+	"display_name":                 getAccTestResourceName(),
+	"description":                  "terraform updated",
+	"connection_initiation_mode":   "RESPOND_ONLY",
+	"enabled":                      "false",
+	"peer_address":                 "30.30.0.20",
+	"peer_id":                      "30.30.0.20",
+	"prefix_length":                "30",
+	"ip_addresses.0":               "169.254.153.2",
+	"tcp_mss_clamping.0.direction": "BOTH",
+}
+
+func TestAccResourceNsxtPolicyIPSecVpnSession_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipsec_vpn_session.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnSessionCheckDestroy(state, accTestPolicyIPSecVpnSessionUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnSessionTemplate(true),
+				Check:  testAccNsxtPolicyIPSecVpnSessionCheckAttributes(testResourceName, accTestPolicyIPSecVpnSessionCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPSecVpnSessionTemplate(false),
+				Check:  testAccNsxtPolicyIPSecVpnSessionCheckAttributes(testResourceName, accTestPolicyIPSecVpnSessionUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPSecVpnSessionPolicyBased(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIPSecVpnSessionExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "vpn_type", "PolicyBased"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.sources.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.destinations.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action", "PROTECT"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "ike_profile_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "tunnel_profile_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "dpd_profile_path"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIPSecVpnSession_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_ipsec_vpn_session.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnSessionCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnSessionTemplate(true),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"psk"},
+				ImportStateIdFunc:       testAccNSXPolicyVpnServiceChildImporterGetID(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIPSecVpnSessionCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyIPSecVpnSessionExists(resourceName),
+		resource.TestCheckResourceAttr(resourceName, "vpn_type", "RouteBased"),
+		resource.TestCheckResourceAttrSet(resourceName, "ike_profile_path"),
+		resource.TestCheckResourceAttrSet(resourceName, "tunnel_profile_path"),
+		resource.TestCheckResourceAttrSet(resourceName, "local_endpoint_path"),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyIPSecVpnSessionExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy IPSec VPN Session resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy IPSec VPN Session resource ID not set in resources")
+		}
+
+		isT0, gwID, localeServiceID, serviceID := parseVpnServicePolicyPath(rs.Primary.Attributes["service_path"], ipSecVpnServicesPathSegment)
+		exists, err := resourceNsxtPolicyIPSecVpnSessionExists(isT0, gwID, localeServiceID, serviceID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("IPSec VPN Session ID %s does not exist on backend", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyIPSecVpnSessionCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_ipsec_vpn_session" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		isT0, gwID, localeServiceID, serviceID := parseVpnServicePolicyPath(rs.Primary.Attributes["service_path"], ipSecVpnServicesPathSegment)
+		exists, err := resourceNsxtPolicyIPSecVpnSessionExists(isT0, gwID, localeServiceID, serviceID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Policy IPSec VPN Session %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyIPSecVpnSessionPrerequisites() string {
+	return testAccNsxtPolicyIPSecVpnLocalEndpointPrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_local_endpoint" "test" {
+  service_path  = nsxt_policy_ipsec_vpn_service.test.path
+  display_name  = "%s"
+  local_address = "20.20.0.10"
+}
+
+resource "nsxt_policy_ipsec_vpn_ike_profile" "test" {
+  display_name          = "%s"
+  dh_groups             = ["GROUP14"]
+  encryption_algorithms = ["AES_GCM_128"]
+}
+
+resource "nsxt_policy_ipsec_vpn_tunnel_profile" "test" {
+  display_name          = "%s"
+  dh_groups             = ["GROUP14"]
+  encryption_algorithms = ["AES_GCM_128"]
+}`, accTestPolicyIPSecVpnServiceHelperName, accTestPolicyIPSecVpnServiceHelperName, accTestPolicyIPSecVpnServiceHelperName)
+}
+
+func testAccNsxtPolicyIPSecVpnSessionTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyIPSecVpnSessionCreateAttributes
+	} else {
+		attrMap = accTestPolicyIPSecVpnSessionUpdateAttributes
+	}
+	return testAccNsxtPolicyIPSecVpnSessionPrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_session" "test" {
+  service_path        = nsxt_policy_ipsec_vpn_service.test.path
+  local_endpoint_path = nsxt_policy_ipsec_vpn_local_endpoint.test.path
+  ike_profile_path    = nsxt_policy_ipsec_vpn_ike_profile.test.path
+  tunnel_profile_path = nsxt_policy_ipsec_vpn_tunnel_profile.test.path
+
+  display_name               = "%s"
+  description                = "%s"
+  connection_initiation_mode = "%s"
+  enabled                    = %s
+  peer_address               = "%s"
+  peer_id                    = "%s"
+  psk                        = "secret1"
+  prefix_length              = %s
+  ip_addresses               = ["%s"]
+
+  tcp_mss_clamping {
+    direction = "%s"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["connection_initiation_mode"], attrMap["enabled"], attrMap["peer_address"], attrMap["peer_id"], attrMap["prefix_length"], attrMap["ip_addresses.0"], attrMap["tcp_mss_clamping.0.direction"])
+}
+
+func testAccNsxtPolicyIPSecVpnSessionPolicyBased() string {
+	return testAccNsxtPolicyIPSecVpnSessionPrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_session" "test" {
+  service_path        = nsxt_policy_ipsec_vpn_service.test.path
+  local_endpoint_path = nsxt_policy_ipsec_vpn_local_endpoint.test.path
+  vpn_type            = "PolicyBased"
+  display_name        = "%s"
+  peer_address        = "30.30.0.10"
+  peer_id             = "30.30.0.10"
+  psk                 = "secret1"
+
+  rule {
+    sources      = ["192.168.10.0/24"]
+    destinations = ["192.168.20.0/24"]
+  }
+}`, accTestPolicyIPSecVpnSessionUpdateAttributes["display_name"])
+}
+
+func TestResourceNsxtPolicyIPSecVpnSessionRead(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet || r.URL.Path != "/policy/api/v1/infra/tier-1s/gw1/locale-services/default/ipsec-vpn-services/vpn1/sessions/session1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+	defer server.Close()
+	m := nsxtClients{PolicyHTTPClient: server.Client(), Host: server.URL, PolicyEnforcementPoint: "default"}
+
+	body = `{"resource_type": "RouteBasedIPSecVpnSession", "id": "session1", "display_name": "route", "_revision": 2,
+	  "local_endpoint_path": "/infra/le1", "ike_profile_path": "/infra/ike1", "tunnel_profile_path": "/infra/tunnel1",
+	  "authentication_mode": "PSK", "peer_address": "30.30.0.10", "peer_id": "peer1", "enabled": true,
+	  "tcp_mss_clamping": {"direction": "BOTH", "max_segment_size": 1360},
+	  "tunnel_interfaces": [{"ip_subnets": [{"ip_addresses": ["169.254.152.2"], "prefix_length": 30}]}]}`
+	d := schema.TestResourceDataRaw(t, resourceNsxtPolicyIPSecVpnSession().Schema, map[string]interface{}{
+		"service_path": "/infra/tier-1s/gw1/locale-services/default/ipsec-vpn-services/vpn1",
+		"psk":          "secret1",
+	})
+	d.SetId("session1")
+	err := resourceNsxtPolicyIPSecVpnSessionRead(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("vpn_type").(string) != "RouteBased" || d.Get("ike_profile_path").(string) != "/infra/ike1" || d.Get("psk").(string) != "secret1" {
+		t.Errorf("Unexpected route based session attributes %v", d.State())
+	}
+	if d.Get("tcp_mss_clamping.0.direction").(string) != "BOTH" || d.Get("tcp_mss_clamping.0.max_segment_size").(int) != 1360 {
+		t.Errorf("Expected tcp_mss_clamping to be restored, got %v", d.Get("tcp_mss_clamping"))
+	}
+	if d.Get("ip_addresses.0").(string) != "169.254.152.2" || d.Get("prefix_length").(int) != 30 {
+		t.Errorf("Expected tunnel interface to be restored, got %v/%v", d.Get("ip_addresses"), d.Get("prefix_length"))
+	}
+
+	body = `{"resource_type": "PolicyBasedIPSecVpnSession", "id": "session1", "display_name": "policy", "_revision": 1,
+	  "local_endpoint_path": "/infra/le1", "peer_address": "30.30.0.10", "peer_id": "peer1",
+	  "rules": [{"id": "rule1", "action": "BYPASS", "enabled": true, "logged": true,
+	    "sources": [{"subnet": "192.168.10.0/24"}], "destinations": [{"subnet": "192.168.20.0/24"}, {"subnet": "192.168.30.0/24"}]}]}`
+	d = schema.TestResourceDataRaw(t, resourceNsxtPolicyIPSecVpnSession().Schema, map[string]interface{}{
+		"service_path": "/infra/tier-1s/gw1/locale-services/default/ipsec-vpn-services/vpn1",
+	})
+	d.SetId("session1")
+	err = resourceNsxtPolicyIPSecVpnSessionRead(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("vpn_type").(string) != "PolicyBased" || d.Get("rule.#").(int) != 1 {
+		t.Fatalf("Expected policy based session with one rule, got %v", d.State())
+	}
+	if d.Get("rule.0.nsx_id").(string) != "rule1" || d.Get("rule.0.action").(string) != "BYPASS" || !d.Get("rule.0.logged").(bool) {
+		t.Errorf("Unexpected rule attributes %v", d.Get("rule"))
+	}
+	if d.Get("rule.0.sources.#").(int) != 1 || d.Get("rule.0.destinations.#").(int) != 2 {
+		t.Errorf("Expected rule subnets to be restored, got %v", d.Get("rule"))
+	}
+}
+
+func TestPolicyIPSecVpnSessionPatch(t *testing.T) {
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPatch || r.URL.Path != "/policy/api/v1/infra/tier-0s/gw0/locale-services/default/ipsec-vpn-services/vpn1/sessions/session1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+	m := nsxtClients{PolicyHTTPClient: server.Client(), Host: server.URL, PolicyEnforcementPoint: "default"}
+
+	d := schema.TestResourceDataRaw(t, resourceNsxtPolicyIPSecVpnSession().Schema, map[string]interface{}{
+		"service_path":        "/infra/tier-0s/gw0/locale-services/default/ipsec-vpn-services/vpn1",
+		"vpn_type":            "PolicyBased",
+		"local_endpoint_path": "/infra/le1",
+		"peer_address":        "30.30.0.10",
+		"peer_id":             "peer1",
+		"psk":                 "secret1",
+		"rule": []interface{}{map[string]interface{}{
+			"sources":      []interface{}{"192.168.10.0/24"},
+			"destinations": []interface{}{"192.168.20.0/24"},
+		}},
+	})
+	err := policyIPSecVpnSessionPatch(d, m, true, "gw0", "default", "vpn1", "session1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received["resource_type"] != "PolicyBasedIPSecVpnSession" || received["psk"] != "secret1" {
+		t.Errorf("Unexpected session payload %v", received)
+	}
+	rules, ok := received["rules"].([]interface{})
+	if !ok || len(rules) != 1 {
+		t.Fatalf("Expected one rule in payload, got %v", received)
+	}
+	rule := rules[0].(map[string]interface{})
+	if rule["id"] == "" || rule["action"] != "PROTECT" || fmt.Sprintf("%v", rule["sources"]) != "[map[subnet:192.168.10.0/24]]" {
+		t.Errorf("Unexpected rule payload %v", rule)
+	}
+	if _, ok := received["tunnel_interfaces"]; ok {
+		t.Errorf("Unexpected tunnel interfaces for policy based session %v", received)
+	}
+}
@@ -0,0 +1,163 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIPSecVpnTunnelProfileCreateAttributes = map[string]string{
+	"display_name":                   getAccTestResourceName(),
+	"description":                    "terraform created",
+	"df_policy":                      "COPY",
+	"dh_groups.#":                    "1",
+	"digest_algorithms.#":            "1",
+	"enable_perfect_forward_secrecy": "true",
+	"encryption_algorithms.#":        "1",
+	"sa_life_time":                   "7200",
+}
+
+var accTestPolicyIPSecVpnTunnelProfileUpdateAttributes = map[string]string{
+	"display_name":                   getAccTestResourceName(),
+	"description":                    "terraform updated",
+	"df_policy":                      "CLEAR",
+	"dh_groups.#":                    "2",
+	"digest_algorithms.#":            "2",
+	"enable_perfect_forward_secrecy": "false",
+	"encryption_algorithms.#":        "2",
+	"sa_life_time":                   "14400",
+}
+
+func TestAccResourceNsxtPolicyIPSecVpnTunnelProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipsec_vpn_tunnel_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnTunnelProfileCheckDestroy(state, accTestPolicyIPSecVpnTunnelProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnTunnelProfileTemplate(true),
+				Check:  testAccNsxtPolicyIPSecVpnTunnelProfileCheckAttributes(testResourceName, accTestPolicyIPSecVpnTunnelProfileCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPSecVpnTunnelProfileTemplate(false),
+				Check:  testAccNsxtPolicyIPSecVpnTunnelProfileCheckAttributes(testResourceName, accTestPolicyIPSecVpnTunnelProfileUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyIPSecVpnTunnelProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIPSecVpnTunnelProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "df_policy", "COPY"),
+					resource.TestCheckResourceAttr(testResourceName, "enable_perfect_forward_secrecy", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "sa_life_time", "3600"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIPSecVpnTunnelProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_ipsec_vpn_tunnel_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnTunnelProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnTunnelProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIPSecVpnTunnelProfileCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyIPSecVpnTunnelProfileExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyIPSecVpnTunnelProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyIPSecVpnTunnelProfileExists)
+}
+
+func testAccNsxtPolicyIPSecVpnTunnelProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_ipsec_vpn_tunnel_profile", resourceNsxtPolicyIPSecVpnTunnelProfileExists)
+}
+
+func testAccNsxtPolicyIPSecVpnTunnelProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	var dhGroups, digestAlgorithms, encryptionAlgorithms string
+	if createFlow {
+		attrMap = accTestPolicyIPSecVpnTunnelProfileCreateAttributes
+		dhGroups = `["GROUP14"]`
+		digestAlgorithms = `["SHA2_256"]`
+		encryptionAlgorithms = `["AES_128"]`
+	} else {
+		attrMap = accTestPolicyIPSecVpnTunnelProfileUpdateAttributes
+		dhGroups = `["GROUP14", "GROUP19"]`
+		digestAlgorithms = `["SHA2_256", "SHA2_512"]`
+		encryptionAlgorithms = `["AES_128", "AES_256"]`
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_tunnel_profile" "test" {
+  display_name                   = "%s"
+  description                    = "%s"
+  df_policy                      = "%s"
+  dh_groups                      = %s
+  digest_algorithms              = %s
+  enable_perfect_forward_secrecy = %s
+  encryption_algorithms          = %s
+  sa_life_time                   = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["df_policy"], dhGroups, digestAlgorithms, attrMap["enable_perfect_forward_secrecy"], encryptionAlgorithms, attrMap["sa_life_time"])
+}
+
+func testAccNsxtPolicyIPSecVpnTunnelProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_tunnel_profile" "test" {
+  display_name          = "%s"
+  dh_groups             = ["GROUP14"]
+  encryption_algorithms = ["AES_GCM_128"]
+}`, accTestPolicyIPSecVpnTunnelProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,391 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var ipv6NdraProfileRaModeValues = []string{
+	model.Ipv6NdraProfile_RA_MODE_DISABLED,
+	model.Ipv6NdraProfile_RA_MODE_SLAAC_DNS_THROUGH_RA,
+	model.Ipv6NdraProfile_RA_MODE_SLAAC_DNS_THROUGH_DHCP,
+	model.Ipv6NdraProfile_RA_MODE_DHCP_ADDRESS_AND_DNS_THROUGH_DHCP,
+	model.Ipv6NdraProfile_RA_MODE_SLAAC_AND_ADDRESS_DNS_THROUGH_DHCP,
+}
+
+func resourceNsxtPolicyIpv6NdraProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIpv6NdraProfileCreate,
+		Read:   resourceNsxtPolicyIpv6NdraProfileRead,
+		Update: resourceNsxtPolicyIpv6NdraProfileUpdate,
+		Delete: resourceNsxtPolicyIpv6NdraProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"ra_mode": {
+				Type:         schema.TypeString,
+				Description:  "RA Mode",
+				Optional:     true,
+				Default:      model.Ipv6NdraProfile_RA_MODE_SLAAC_DNS_THROUGH_RA,
+				ValidateFunc: validation.StringInSlice(ipv6NdraProfileRaModeValues, false),
+			},
+			"reachable_timer": {
+				Type:         schema.TypeInt,
+				Description:  "Neighbour reachable time duration in milliseconds",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 3600000),
+			},
+			"retransmit_interval": {
+				Type:         schema.TypeInt,
+				Description:  "The time, in milliseconds, between retransmitted neighbour solicitation messages",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"dns_config": {
+				Type:        schema.TypeList,
+				Description: "DNS Configuration",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        getIpv6NdraProfileDNSConfigSchema(),
+			},
+			"ra_config": {
+				Type:        schema.TypeList,
+				Description: "RA Configuration",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        getIpv6NdraProfileRAConfigSchema(),
+			},
+		},
+	}
+}
+
+func getIpv6NdraProfileDNSConfigSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"dns_server": {
+				Type:        schema.TypeList,
+				Description: "IPv6 DNS server addresses",
+				Optional:    true,
+				MaxItems:    8,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv6Address,
+				},
+			},
+			"dns_server_lifetime": {
+				Type:         schema.TypeInt,
+				Description:  "Lifetime of DNS server in milliseconds",
+				Optional:     true,
+				Default:      1800000,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"domain_name": {
+				Type:        schema.TypeList,
+				Description: "Domain names in RA message",
+				Optional:    true,
+				MaxItems:    8,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"domain_name_lifetime": {
+				Type:         schema.TypeInt,
+				Description:  "Lifetime of domain names in milliseconds",
+				Optional:     true,
+				Default:      1800000,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func getIpv6NdraProfileRAConfigSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hop_limit": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of hops through which packets can pass before being discarded",
+				Optional:     true,
+				Default:      64,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+			"prefix_lifetime": {
+				Type:         schema.TypeInt,
+				Description:  "The time interval in seconds, in which the prefix is advertised as valid",
+				Optional:     true,
+				Default:      2592000,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"prefix_preferred_time": {
+				Type:         schema.TypeInt,
+				Description:  "The time interval in seconds, in which the prefix is advertised as preferred",
+				Optional:     true,
+				Default:      604800,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"ra_interval": {
+				Type:         schema.TypeInt,
+				Description:  "Interval between 2 Router advertisement in seconds",
+				Optional:     true,
+				Default:      600,
+				ValidateFunc: validation.IntBetween(4, 1800),
+			},
+			"router_lifetime": {
+				Type:         schema.TypeInt,
+				Description:  "Router lifetime value in seconds",
+				Optional:     true,
+				Default:      1800,
+				ValidateFunc: validation.IntBetween(0, 9000),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIpv6NdraProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDefaultIpv6NdraProfilesClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewDefaultIpv6NdraProfilesClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getIpv6NdraProfileFromSchema(d *schema.ResourceData) model.Ipv6NdraProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	raMode := d.Get("ra_mode").(string)
+	reachableTimer := int64(d.Get("reachable_timer").(int))
+	retransmitInterval := int64(d.Get("retransmit_interval").(int))
+
+	obj := model.Ipv6NdraProfile{
+		DisplayName:        &displayName,
+		Description:        &description,
+		Tags:               tags,
+		RaMode:             &raMode,
+		ReachableTimer:     &reachableTimer,
+		RetransmitInterval: &retransmitInterval,
+	}
+
+	dnsConfigs := d.Get("dns_config").([]interface{})
+	if len(dnsConfigs) > 0 && dnsConfigs[0] != nil {
+		dnsConfig := dnsConfigs[0].(map[string]interface{})
+		dnsServerLifetime := int64(dnsConfig["dns_server_lifetime"].(int))
+		domainNameLifetime := int64(dnsConfig["domain_name_lifetime"].(int))
+		obj.DnsConfig = &model.RaDNSConfig{
+			DnsServer:          interface2StringList(dnsConfig["dns_server"].([]interface{})),
+			DnsServerLifetime:  &dnsServerLifetime,
+			DomainName:         interface2StringList(dnsConfig["domain_name"].([]interface{})),
+			DomainNameLifetime: &domainNameLifetime,
+		}
+	}
+
+	raConfigs := d.Get("ra_config").([]interface{})
+	if len(raConfigs) > 0 && raConfigs[0] != nil {
+		raConfig := raConfigs[0].(map[string]interface{})
+		hopLimit := int64(raConfig["hop_limit"].(int))
+		prefixLifetime := int64(raConfig["prefix_lifetime"].(int))
+		prefixPreferredTime := int64(raConfig["prefix_preferred_time"].(int))
+		raInterval := int64(raConfig["ra_interval"].(int))
+		routerLifetime := int64(raConfig["router_lifetime"].(int))
+		obj.RaConfig = &model.RAConfig{
+			HopLimit:            &hopLimit,
+			PrefixLifetime:      &prefixLifetime,
+			PrefixPreferredTime: &prefixPreferredTime,
+			RaInterval:          &raInterval,
+			RouterLifetime:      &routerLifetime,
+		}
+	}
+
+	return obj
+}
+
+func setIpv6NdraProfileInSchema(d *schema.ResourceData, obj model.Ipv6NdraProfile) error {
+	d.Set("ra_mode", obj.RaMode)
+	d.Set("reachable_timer", obj.ReachableTimer)
+	d.Set("retransmit_interval", obj.RetransmitInterval)
+
+	var dnsConfigs []interface{}
+	if obj.DnsConfig != nil {
+		dnsConfig := make(map[string]interface{})
+		dnsConfig["dns_server"] = obj.DnsConfig.DnsServer
+		dnsConfig["dns_server_lifetime"] = obj.DnsConfig.DnsServerLifetime
+		dnsConfig["domain_name"] = obj.DnsConfig.DomainName
+		dnsConfig["domain_name_lifetime"] = obj.DnsConfig.DomainNameLifetime
+		dnsConfigs = append(dnsConfigs, dnsConfig)
+	}
+	err := d.Set("dns_config", dnsConfigs)
+	if err != nil {
+		return err
+	}
+
+	var raConfigs []interface{}
+	if obj.RaConfig != nil {
+		raConfig := make(map[string]interface{})
+		raConfig["hop_limit"] = obj.RaConfig.HopLimit
+		raConfig["prefix_lifetime"] = obj.RaConfig.PrefixLifetime
+		raConfig["prefix_preferred_time"] = obj.RaConfig.PrefixPreferredTime
+		raConfig["ra_interval"] = obj.RaConfig.RaInterval
+		raConfig["router_lifetime"] = obj.RaConfig.RouterLifetime
+		raConfigs = append(raConfigs, raConfig)
+	}
+	return d.Set("ra_config", raConfigs)
+}
+
+func patchNsxtPolicyIpv6NdraProfile(connector *client.RestConnector, id string, obj model.Ipv6NdraProfile, isGlobalManager bool) error {
+	boolFalse := false
+	if isGlobalManager {
+		gmObj, err := convertModelBindingType(obj, model.Ipv6NdraProfileBindingType(), gm_model.Ipv6NdraProfileBindingType())
+		if err != nil {
+			return err
+		}
+
+		client := gm_infra.NewDefaultIpv6NdraProfilesClient(connector)
+		return client.Patch(id, gmObj.(gm_model.Ipv6NdraProfile), &boolFalse)
+	}
+
+	client := infra.NewDefaultIpv6NdraProfilesClient(connector)
+	return client.Patch(id, obj, &boolFalse)
+}
+
+func resourceNsxtPolicyIpv6NdraProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIpv6NdraProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getIpv6NdraProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating Ipv6NdraProfile with ID %s", id)
+	err = patchNsxtPolicyIpv6NdraProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleCreateError("Ipv6NdraProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIpv6NdraProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpv6NdraProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Ipv6NdraProfile ID")
+	}
+
+	var obj model.Ipv6NdraProfile
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultIpv6NdraProfilesClient(connector)
+		gmObj, err := client.Get(id)
+		if err != nil {
+			return handleReadError(d, "Ipv6NdraProfile", id, err)
+		}
+		rawObj, err := convertModelBindingType(gmObj, gm_model.Ipv6NdraProfileBindingType(), model.Ipv6NdraProfileBindingType())
+		if err != nil {
+			return err
+		}
+		obj = rawObj.(model.Ipv6NdraProfile)
+	} else {
+		var err error
+		client := infra.NewDefaultIpv6NdraProfilesClient(connector)
+		obj, err = client.Get(id)
+		if err != nil {
+			return handleReadError(d, "Ipv6NdraProfile", id, err)
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	return setIpv6NdraProfileInSchema(d, obj)
+}
+
+func resourceNsxtPolicyIpv6NdraProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Ipv6NdraProfile ID")
+	}
+
+	obj := getIpv6NdraProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	log.Printf("[INFO] Updating Ipv6NdraProfile with ID %s", id)
+	err := patchNsxtPolicyIpv6NdraProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleUpdateError("Ipv6NdraProfile", id, err)
+	}
+
+	return resourceNsxtPolicyIpv6NdraProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpv6NdraProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Ipv6NdraProfile ID")
+	}
+
+	var err error
+	connector := getPolicyConnector(m)
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultIpv6NdraProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	} else {
+		client := infra.NewDefaultIpv6NdraProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	}
+
+	if err != nil {
+		return handleDeleteError("Ipv6NdraProfile", id, err)
+	}
+
+	return nil
+}
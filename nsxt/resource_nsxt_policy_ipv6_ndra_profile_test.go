@@ -0,0 +1,216 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIpv6NdraProfileCreateAttributes = map[string]string{
+	"display_name":          getAccTestResourceName(),
+	"description":           "terraform created",
+	"ra_mode":               "SLAAC_DNS_THROUGH_RA",
+	"reachable_timer":       "1000",
+	"retransmit_interval":   "2000",
+	"dns_server":            "2001::1",
+	"domain_name":           "example.org",
+	"hop_limit":             "32",
+	"ra_interval":           "300",
+	"router_lifetime":       "900",
+	"prefix_preferred_time": "302400",
+}
+
+var accTestPolicyIpv6NdraProfileUpdateAttributes = map[string]string{
+	"display_name":          getAccTestResourceName(),
+	"description":           "terraform updated",
+	"ra_mode":               "SLAAC_DNS_THROUGH_DHCP",
+	"reachable_timer":       "0",
+	"retransmit_interval":   "0",
+	"dns_server":            "2001::2",
+	"domain_name":           "example.com",
+	"hop_limit":             "64",
+	"ra_interval":           "600",
+	"router_lifetime":       "1800",
+	"prefix_preferred_time": "604800",
+}
+
+func TestAccResourceNsxtPolicyIpv6NdraProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipv6_ndra_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpv6NdraProfileCheckDestroy(state, accTestPolicyIpv6NdraProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpv6NdraProfileTemplate(true),
+				Check:  testAccNsxtPolicyIpv6NdraProfileCheckAttributes(testResourceName, accTestPolicyIpv6NdraProfileCreateAttributes),
+			},
+			{
+				Config: testAccNsxtPolicyIpv6NdraProfileTemplate(false),
+				Check:  testAccNsxtPolicyIpv6NdraProfileCheckAttributes(testResourceName, accTestPolicyIpv6NdraProfileUpdateAttributes),
+			},
+			{
+				Config: testAccNsxtPolicyIpv6NdraProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpv6NdraProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "ra_mode", "SLAAC_DNS_THROUGH_RA"),
+					resource.TestCheckResourceAttr(testResourceName, "ra_config.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "ra_config.0.hop_limit"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIpv6NdraProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_ipv6_ndra_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpv6NdraProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpv6NdraProfileTemplate(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIpv6NdraProfileCheckAttributes(resourceName string, attrMap map[string]string) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		testAccNsxtPolicyIpv6NdraProfileExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+		resource.TestCheckResourceAttr(resourceName, "display_name", attrMap["display_name"]),
+		resource.TestCheckResourceAttr(resourceName, "description", attrMap["description"]),
+		resource.TestCheckResourceAttr(resourceName, "ra_mode", attrMap["ra_mode"]),
+		resource.TestCheckResourceAttr(resourceName, "reachable_timer", attrMap["reachable_timer"]),
+		resource.TestCheckResourceAttr(resourceName, "retransmit_interval", attrMap["retransmit_interval"]),
+		resource.TestCheckResourceAttr(resourceName, "dns_config.#", "1"),
+		resource.TestCheckResourceAttr(resourceName, "dns_config.0.dns_server.0", attrMap["dns_server"]),
+		resource.TestCheckResourceAttr(resourceName, "dns_config.0.domain_name.0", attrMap["domain_name"]),
+		resource.TestCheckResourceAttr(resourceName, "ra_config.#", "1"),
+		resource.TestCheckResourceAttr(resourceName, "ra_config.0.hop_limit", attrMap["hop_limit"]),
+		resource.TestCheckResourceAttr(resourceName, "ra_config.0.ra_interval", attrMap["ra_interval"]),
+		resource.TestCheckResourceAttr(resourceName, "ra_config.0.router_lifetime", attrMap["router_lifetime"]),
+		resource.TestCheckResourceAttr(resourceName, "ra_config.0.prefix_preferred_time", attrMap["prefix_preferred_time"]),
+	)
+}
+
+func testAccNsxtPolicyIpv6NdraProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyIpv6NdraProfileExists)
+}
+
+func testAccNsxtPolicyIpv6NdraProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_ipv6_ndra_profile", resourceNsxtPolicyIpv6NdraProfileExists)
+}
+
+func testAccNsxtPolicyIpv6NdraProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyIpv6NdraProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyIpv6NdraProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipv6_ndra_profile" "test" {
+  display_name        = "%s"
+  description         = "%s"
+  ra_mode             = "%s"
+  reachable_timer     = %s
+  retransmit_interval = %s
+
+  dns_config {
+    dns_server  = ["%s"]
+    domain_name = ["%s"]
+  }
+
+  ra_config {
+    hop_limit             = %s
+    ra_interval           = %s
+    router_lifetime       = %s
+    prefix_preferred_time = %s
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["ra_mode"], attrMap["reachable_timer"], attrMap["retransmit_interval"], attrMap["dns_server"], attrMap["domain_name"], attrMap["hop_limit"], attrMap["ra_interval"], attrMap["router_lifetime"], attrMap["prefix_preferred_time"])
+}
+
+func testAccNsxtPolicyIpv6NdraProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipv6_ndra_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyIpv6NdraProfileUpdateAttributes["display_name"])
+}
+
+// RA and DNS configuration are expected to survive a round trip through the API model
+func TestIpv6NdraProfileSchemaRoundTrip(t *testing.T) {
+	profileSchema := resourceNsxtPolicyIpv6NdraProfile().Schema
+	d := schema.TestResourceDataRaw(t, profileSchema, map[string]interface{}{
+		"display_name": "test",
+		"ra_mode":      "DISABLED",
+		"dns_config": []interface{}{map[string]interface{}{
+			"dns_server":  []interface{}{"2001::1"},
+			"domain_name": []interface{}{"example.org"},
+		}},
+		"ra_config": []interface{}{map[string]interface{}{
+			"hop_limit":   32,
+			"ra_interval": 300,
+		}},
+	})
+
+	obj := getIpv6NdraProfileFromSchema(d)
+	if *obj.RaConfig.HopLimit != 32 || *obj.RaConfig.RouterLifetime != 1800 {
+		t.Errorf("Unexpected RA config %+v", obj.RaConfig)
+	}
+
+	restored := schema.TestResourceDataRaw(t, profileSchema, map[string]interface{}{})
+	err := setIpv6NdraProfileInSchema(restored, obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"ra_mode":                          "DISABLED",
+		"dns_config.0.dns_server.0":        "2001::1",
+		"dns_config.0.domain_name.0":       "example.org",
+		"dns_config.0.dns_server_lifetime": 1800000,
+		"ra_config.0.hop_limit":            32,
+		"ra_config.0.ra_interval":          300,
+		"ra_config.0.router_lifetime":      1800,
+		"ra_config.0.prefix_lifetime":      2592000,
+	}
+	for key, value := range expected {
+		if restored.Get(key) != value {
+			t.Errorf("Expected %s to be %v, got %v", key, value, restored.Get(key))
+		}
+	}
+}
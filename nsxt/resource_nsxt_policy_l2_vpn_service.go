@@ -0,0 +1,261 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t0_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
+	t1_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var l2VpnServiceModeValues = []string{
+	model.L2VPNService_MODE_SERVER,
+	model.L2VPNService_MODE_CLIENT,
+}
+
+func resourceNsxtPolicyL2VpnService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyL2VpnServiceCreate,
+		Read:   resourceNsxtPolicyL2VpnServiceRead,
+		Update: resourceNsxtPolicyL2VpnServiceUpdate,
+		Delete: resourceNsxtPolicyL2VpnServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyL2VpnServiceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":              getNsxIDSchema(),
+			"path":                getPathSchema(),
+			"display_name":        getDisplayNameSchema(),
+			"description":         getDescriptionSchema(),
+			"revision":            getRevisionSchema(),
+			"tag":                 getTagsSchema(),
+			"locale_service_path": getPolicyPathSchema(true, true, "Policy path of the gateway locale service for this L2 VPN service"),
+			"enable_hub": {
+				Type:        schema.TypeBool,
+				Description: "Replicate traffic from any client to all other clients, applicable in SERVER mode only",
+				Optional:    true,
+				Default:     true,
+			},
+			"encap_ip_pool": {
+				Type:        schema.TypeList,
+				Description: "IP Pool to allocate local and peer endpoint IPs for L2 VPN session logical tap",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCidr(),
+				},
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Description:  "L2 VPN service mode",
+				Optional:     true,
+				Default:      model.L2VPNService_MODE_SERVER,
+				ValidateFunc: validation.StringInSlice(l2VpnServiceModeValues, false),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyL2VpnServiceExists(isT0 bool, gwID string, localeServiceID string, id string, connector *client.RestConnector) (bool, error) {
+	var err error
+	if isT0 {
+		client := t0_locale_services.NewDefaultL2vpnServicesClient(connector)
+		_, err = client.Get(gwID, localeServiceID, id)
+	} else {
+		client := t1_locale_services.NewDefaultL2vpnServicesClient(connector)
+		_, err = client.Get(gwID, localeServiceID, id)
+	}
+
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func policyL2VpnServicePatch(d *schema.ResourceData, m interface{}, isT0 bool, gwID string, localeServiceID string, id string) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	enableHub := d.Get("enable_hub").(bool)
+	mode := d.Get("mode").(string)
+
+	obj := model.L2VPNService{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		EnableHub:   &enableHub,
+		EncapIpPool: getStringListFromSchemaList(d, "encap_ip_pool"),
+		Mode:        &mode,
+	}
+
+	connector := getPolicyConnector(m)
+	if isT0 {
+		client := t0_locale_services.NewDefaultL2vpnServicesClient(connector)
+		return client.Patch(gwID, localeServiceID, id, obj)
+	}
+
+	client := t1_locale_services.NewDefaultL2vpnServicesClient(connector)
+	return client.Patch(gwID, localeServiceID, id, obj)
+}
+
+func resourceNsxtPolicyL2VpnServiceCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	localeServicePath := d.Get("locale_service_path").(string)
+	isT0, gwID, localeServiceID := parseLocaleServicePolicyPath(localeServicePath)
+	if gwID == "" {
+		return fmt.Errorf("Expecting gateway locale service path, got %s", localeServicePath)
+	}
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicyL2VpnServiceExists(isT0, gwID, localeServiceID, id, getPolicyConnector(m))
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Resource with id %s already exists", id)
+		}
+	}
+
+	log.Printf("[INFO] Creating L2VpnService with ID %s", id)
+	err := policyL2VpnServicePatch(d, m, isT0, gwID, localeServiceID, id)
+	if err != nil {
+		return handleCreateError("L2VpnService", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyL2VpnServiceRead(d, m)
+}
+
+func resourceNsxtPolicyL2VpnServiceRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining L2VpnService ID")
+	}
+
+	localeServicePath := d.Get("locale_service_path").(string)
+	isT0, gwID, localeServiceID := parseLocaleServicePolicyPath(localeServicePath)
+	if gwID == "" {
+		return fmt.Errorf("Expecting gateway locale service path, got %s", localeServicePath)
+	}
+
+	var obj model.L2VPNService
+	var err error
+	if isT0 {
+		client := t0_locale_services.NewDefaultL2vpnServicesClient(connector)
+		obj, err = client.Get(gwID, localeServiceID, id)
+	} else {
+		client := t1_locale_services.NewDefaultL2vpnServicesClient(connector)
+		obj, err = client.Get(gwID, localeServiceID, id)
+	}
+	if err != nil {
+		return handleReadError(d, "L2VpnService", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("enable_hub", obj.EnableHub)
+	d.Set("encap_ip_pool", obj.EncapIpPool)
+	d.Set("mode", obj.Mode)
+
+	return nil
+}
+
+func resourceNsxtPolicyL2VpnServiceUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining L2VpnService ID")
+	}
+
+	localeServicePath := d.Get("locale_service_path").(string)
+	isT0, gwID, localeServiceID := parseLocaleServicePolicyPath(localeServicePath)
+	if gwID == "" {
+		return fmt.Errorf("Expecting gateway locale service path, got %s", localeServicePath)
+	}
+
+	err := policyL2VpnServicePatch(d, m, isT0, gwID, localeServiceID, id)
+	if err != nil {
+		return handleUpdateError("L2VpnService", id, err)
+	}
+
+	return resourceNsxtPolicyL2VpnServiceRead(d, m)
+}
+
+func resourceNsxtPolicyL2VpnServiceDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining L2VpnService ID")
+	}
+
+	localeServicePath := d.Get("locale_service_path").(string)
+	isT0, gwID, localeServiceID := parseLocaleServicePolicyPath(localeServicePath)
+	if gwID == "" {
+		return fmt.Errorf("Expecting gateway locale service path, got %s", localeServicePath)
+	}
+
+	connector := getPolicyConnector(m)
+	var err error
+	if isT0 {
+		client := t0_locale_services.NewDefaultL2vpnServicesClient(connector)
+		err = client.Delete(gwID, localeServiceID, id)
+	} else {
+		client := t1_locale_services.NewDefaultL2vpnServicesClient(connector)
+		err = client.Delete(gwID, localeServiceID, id)
+	}
+	if err != nil {
+		return handleDeleteError("L2VpnService", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyL2VpnServiceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	s := strings.Split(importID, "/")
+	if len(s) != 4 || (s[0] != "tier-0s" && s[0] != "tier-1s") {
+		return nil, fmt.Errorf("Please provide <tier-0s|tier-1s>/<gateway-id>/<locale-service-id>/<service-id> as an input")
+	}
+
+	localeServicePath := fmt.Sprintf("/infra/%s/%s/locale-services/%s", s[0], s[1], s[2])
+	exists, err := resourceNsxtPolicyL2VpnServiceExists(s[0] == "tier-0s", s[1], s[2], s[3], getPolicyConnector(m))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("L2VpnService %s not found under %s", s[3], localeServicePath)
+	}
+
+	d.Set("locale_service_path", localeServicePath)
+	d.SetId(s[3])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,225 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyL2VpnServiceCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"enable_hub":   "true",
+	"mode":         "SERVER",
+}
+
+var accTestPolicyL2VpnServiceUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"enable_hub":   "false",
+	"mode":         "SERVER",
+}
+
+var accTestPolicyL2VpnServiceHelperName = getAccTestResourceName()
+
+func TestAccResourceNsxtPolicyL2VpnService_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_l2_vpn_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyL2VpnServiceCheckDestroy(state, accTestPolicyL2VpnServiceUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyL2VpnServiceTemplate(true),
+				Check:  testAccNsxtPolicyL2VpnServiceCheckAttributes(testResourceName, accTestPolicyL2VpnServiceCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyL2VpnServiceTemplate(false),
+				Check:  testAccNsxtPolicyL2VpnServiceCheckAttributes(testResourceName, accTestPolicyL2VpnServiceUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyL2VpnServiceMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyL2VpnServiceExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "enable_hub", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "mode", "SERVER"),
+					resource.TestCheckResourceAttrSet(testResourceName, "locale_service_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyL2VpnService_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_l2_vpn_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyL2VpnServiceCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyL2VpnServiceMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNSXPolicyL2VpnServiceImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNSXPolicyL2VpnServiceImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_l2_vpn_service.test"]
+	if !ok {
+		return "", fmt.Errorf("NSX Policy L2 VPN Service resource %s not found in resources", "nsxt_policy_l2_vpn_service.test")
+	}
+	resourceID := rs.Primary.ID
+	if resourceID == "" {
+		return "", fmt.Errorf("NSX Policy L2 VPN Service resource ID not set in resources ")
+	}
+	localeServicePath := rs.Primary.Attributes["locale_service_path"]
+	if localeServicePath == "" {
+		return "", fmt.Errorf("NSX Policy L2 VPN Service locale_service_path not set in resources ")
+	}
+	// Strip the /infra/ prefix to get <tier-0s>/<gateway-id>/locale-services/<locale-service-id>
+	segs := strings.Split(localeServicePath, "/")
+	if len(segs) != 6 {
+		return "", fmt.Errorf("Unexpected locale_service_path %s", localeServicePath)
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", segs[2], segs[3], segs[5], resourceID), nil
+}
+
+func testAccNsxtPolicyL2VpnServiceCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyL2VpnServiceExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyL2VpnServiceExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy L2 VPN Service resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy L2 VPN Service resource ID not set in resources")
+		}
+
+		isT0, gwID, localeServiceID := parseLocaleServicePolicyPath(rs.Primary.Attributes["locale_service_path"])
+		exists, err := resourceNsxtPolicyL2VpnServiceExists(isT0, gwID, localeServiceID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("L2 VPN Service ID %s does not exist on backend", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyL2VpnServiceCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_l2_vpn_service" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		isT0, gwID, localeServiceID := parseLocaleServicePolicyPath(rs.Primary.Attributes["locale_service_path"])
+		exists, err := resourceNsxtPolicyL2VpnServiceExists(isT0, gwID, localeServiceID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Policy L2 VPN Service %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyL2VpnServicePrerequisites() string {
+	return fmt.Sprintf(`
+data "nsxt_policy_edge_cluster" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name      = "%s"
+  ha_mode           = "ACTIVE_STANDBY"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+}`, getEdgeClusterName(), accTestPolicyL2VpnServiceHelperName)
+}
+
+func testAccNsxtPolicyL2VpnServiceTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyL2VpnServiceCreateAttributes
+	} else {
+		attrMap = accTestPolicyL2VpnServiceUpdateAttributes
+	}
+	return testAccNsxtPolicyL2VpnServicePrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_l2_vpn_service" "test" {
+  locale_service_path = "${nsxt_policy_tier0_gateway.test.path}/locale-services/default"
+
+  display_name  = "%s"
+  description   = "%s"
+  enable_hub    = %s
+  mode          = "%s"
+  encap_ip_pool = ["192.168.10.0/24"]
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["enable_hub"], attrMap["mode"])
+}
+
+func testAccNsxtPolicyL2VpnServiceMinimalistic() string {
+	return testAccNsxtPolicyL2VpnServicePrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_l2_vpn_service" "test" {
+  locale_service_path = "${nsxt_policy_tier0_gateway.test.path}/locale-services/default"
+  display_name        = "%s"
+}`, accTestPolicyL2VpnServiceUpdateAttributes["display_name"])
+}
@@ -0,0 +1,311 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t0_l2vpn_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/l2vpn_services"
+	t1_l2vpn_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services/l2vpn_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const l2VpnServicesPathSegment = "l2vpn-services"
+
+var l2VpnSessionTcpMssClampingDirectionValues = []string{
+	model.L2TcpMaxSegmentSizeClamping_DIRECTION_NONE,
+	model.L2TcpMaxSegmentSizeClamping_DIRECTION_BOTH,
+}
+
+func resourceNsxtPolicyL2VpnSession() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyL2VpnSessionCreate,
+		Read:   resourceNsxtPolicyL2VpnSessionRead,
+		Update: resourceNsxtPolicyL2VpnSessionUpdate,
+		Delete: resourceNsxtPolicyL2VpnSessionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyL2VpnSessionImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"service_path": getPolicyPathSchema(true, true, "Policy path of the L2 VPN service for this session"),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable to extend all the associated segments",
+				Optional:    true,
+				Default:     true,
+			},
+			"transport_tunnels": {
+				Type:        schema.TypeList,
+				Description: "Policy paths of route based IPSec VPN sessions used as transport tunnels",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+			"tcp_mss_clamping": {
+				Type:        schema.TypeList,
+				Description: "TCP maximum segment size (MSS) clamping, supported in SERVER mode only",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"direction": {
+							Type:         schema.TypeString,
+							Description:  "Direction of traffic on which MSS clamping is applied",
+							Optional:     true,
+							Default:      model.L2TcpMaxSegmentSizeClamping_DIRECTION_NONE,
+							ValidateFunc: validation.StringInSlice(l2VpnSessionTcpMssClampingDirectionValues, false),
+						},
+						"max_segment_size": {
+							Type:         schema.TypeInt,
+							Description:  "Maximum segment size, calculated automatically if not set",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(108, 8852),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getL2VpnServiceFromSchema(d *schema.ResourceData) (bool, string, string, string, error) {
+	servicePath := d.Get("service_path").(string)
+	isT0, gwID, localeServiceID, serviceID := parseVpnServicePolicyPath(servicePath, l2VpnServicesPathSegment)
+	if gwID == "" {
+		return false, "", "", "", fmt.Errorf("Expecting L2 VPN service path, got %s", servicePath)
+	}
+
+	return isT0, gwID, localeServiceID, serviceID, nil
+}
+
+func getL2VpnSessionTcpMssClampingFromSchema(d *schema.ResourceData) *model.L2TcpMaxSegmentSizeClamping {
+	clampings := d.Get("tcp_mss_clamping").([]interface{})
+	if len(clampings) == 0 || clampings[0] == nil {
+		return nil
+	}
+
+	clamping := clampings[0].(map[string]interface{})
+	direction := clamping["direction"].(string)
+	obj := model.L2TcpMaxSegmentSizeClamping{
+		Direction: &direction,
+	}
+	maxSegmentSize := int64(clamping["max_segment_size"].(int))
+	if maxSegmentSize > 0 {
+		obj.MaxSegmentSize = &maxSegmentSize
+	}
+
+	return &obj
+}
+
+func setL2VpnSessionTcpMssClampingInSchema(d *schema.ResourceData, obj *model.L2TcpMaxSegmentSizeClamping) error {
+	var clampings []map[string]interface{}
+	if obj != nil {
+		elem := make(map[string]interface{})
+		elem["direction"] = obj.Direction
+		elem["max_segment_size"] = obj.MaxSegmentSize
+		clampings = append(clampings, elem)
+	}
+
+	return d.Set("tcp_mss_clamping", clampings)
+}
+
+func resourceNsxtPolicyL2VpnSessionExists(isT0 bool, gwID string, localeServiceID string, serviceID string, id string, connector *client.RestConnector) (bool, error) {
+	var err error
+	if isT0 {
+		client := t0_l2vpn_services.NewDefaultSessionsClient(connector)
+		_, err = client.Get(gwID, localeServiceID, serviceID, id)
+	} else {
+		client := t1_l2vpn_services.NewDefaultSessionsClient(connector)
+		_, err = client.Get(gwID, localeServiceID, serviceID, id)
+	}
+
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func policyL2VpnSessionPatch(d *schema.ResourceData, m interface{}, isT0 bool, gwID string, localeServiceID string, serviceID string, id string) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	enabled := d.Get("enabled").(bool)
+
+	obj := model.L2VPNSession{
+		DisplayName:      &displayName,
+		Description:      &description,
+		Tags:             tags,
+		Enabled:          &enabled,
+		TransportTunnels: getStringListFromSchemaList(d, "transport_tunnels"),
+		TcpMssClamping:   getL2VpnSessionTcpMssClampingFromSchema(d),
+	}
+
+	connector := getPolicyConnector(m)
+	if isT0 {
+		client := t0_l2vpn_services.NewDefaultSessionsClient(connector)
+		return client.Patch(gwID, localeServiceID, serviceID, id, obj)
+	}
+
+	client := t1_l2vpn_services.NewDefaultSessionsClient(connector)
+	return client.Patch(gwID, localeServiceID, serviceID, id, obj)
+}
+
+func resourceNsxtPolicyL2VpnSessionCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	isT0, gwID, localeServiceID, serviceID, err := getL2VpnServiceFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicyL2VpnSessionExists(isT0, gwID, localeServiceID, serviceID, id, getPolicyConnector(m))
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Resource with id %s already exists", id)
+		}
+	}
+
+	log.Printf("[INFO] Creating L2VpnSession with ID %s", id)
+	err = policyL2VpnSessionPatch(d, m, isT0, gwID, localeServiceID, serviceID, id)
+	if err != nil {
+		return handleCreateError("L2VpnSession", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyL2VpnSessionRead(d, m)
+}
+
+func resourceNsxtPolicyL2VpnSessionRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining L2VpnSession ID")
+	}
+
+	isT0, gwID, localeServiceID, serviceID, err := getL2VpnServiceFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	var obj model.L2VPNSession
+	if isT0 {
+		client := t0_l2vpn_services.NewDefaultSessionsClient(connector)
+		obj, err = client.Get(gwID, localeServiceID, serviceID, id)
+	} else {
+		client := t1_l2vpn_services.NewDefaultSessionsClient(connector)
+		obj, err = client.Get(gwID, localeServiceID, serviceID, id)
+	}
+	if err != nil {
+		return handleReadError(d, "L2VpnSession", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("enabled", obj.Enabled)
+	d.Set("transport_tunnels", obj.TransportTunnels)
+
+	return setL2VpnSessionTcpMssClampingInSchema(d, obj.TcpMssClamping)
+}
+
+func resourceNsxtPolicyL2VpnSessionUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining L2VpnSession ID")
+	}
+
+	isT0, gwID, localeServiceID, serviceID, err := getL2VpnServiceFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	err = policyL2VpnSessionPatch(d, m, isT0, gwID, localeServiceID, serviceID, id)
+	if err != nil {
+		return handleUpdateError("L2VpnSession", id, err)
+	}
+
+	return resourceNsxtPolicyL2VpnSessionRead(d, m)
+}
+
+func resourceNsxtPolicyL2VpnSessionDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining L2VpnSession ID")
+	}
+
+	isT0, gwID, localeServiceID, serviceID, err := getL2VpnServiceFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	if isT0 {
+		client := t0_l2vpn_services.NewDefaultSessionsClient(connector)
+		err = client.Delete(gwID, localeServiceID, serviceID, id)
+	} else {
+		client := t1_l2vpn_services.NewDefaultSessionsClient(connector)
+		err = client.Delete(gwID, localeServiceID, serviceID, id)
+	}
+	if err != nil {
+		return handleDeleteError("L2VpnSession", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyL2VpnSessionImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	servicePath, id, err := parseVpnServiceChildImportID(d.Id(), l2VpnServicesPathSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	isT0, gwID, localeServiceID, serviceID := parseVpnServicePolicyPath(servicePath, l2VpnServicesPathSegment)
+	exists, err := resourceNsxtPolicyL2VpnSessionExists(isT0, gwID, localeServiceID, serviceID, id, getPolicyConnector(m))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("L2VpnSession %s not found under %s", id, servicePath)
+	}
+
+	d.Set("service_path", servicePath)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
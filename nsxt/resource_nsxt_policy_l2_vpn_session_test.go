@@ -0,0 +1,282 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyL2VpnSessionCreateAttributes = map[string]string{
+	"display_name":                 getAccTestResourceName(),
+	"description":                  "terraform created",
+	"enabled":                      "true",
+	"transport_tunnels.#":          "1",
+	"tcp_mss_clamping.0.direction": "NONE",
+}
+
+var accTestPolicyL2VpnSessionUpdateAttributes = map[string]string{
+	"display_name":                 getAccTestResourceName(),
+	"description":                  "terraform updated",
+	"enabled":                      "false",
+	"transport_tunnels.#":          "1",
+	"tcp_mss_clamping.0.direction": "BOTH",
+}
+
+func TestAccResourceNsxtPolicyL2VpnSession_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_l2_vpn_session.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyL2VpnSessionCheckDestroy(state, accTestPolicyL2VpnSessionUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyL2VpnSessionTemplate(true),
+				Check:  testAccNsxtPolicyL2VpnSessionCheckAttributes(testResourceName, accTestPolicyL2VpnSessionCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyL2VpnSessionTemplate(false),
+				Check:  testAccNsxtPolicyL2VpnSessionCheckAttributes(testResourceName, accTestPolicyL2VpnSessionUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyL2VpnSessionMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyL2VpnSessionExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_tunnels.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "transport_tunnels.0", "nsxt_policy_ipsec_vpn_session.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "service_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyL2VpnSession_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_l2_vpn_session.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyL2VpnSessionCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyL2VpnSessionMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNSXPolicyVpnServiceChildImporterGetID(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyL2VpnSessionCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyL2VpnSessionExists(resourceName),
+		resource.TestCheckResourceAttrPair(resourceName, "transport_tunnels.0", "nsxt_policy_ipsec_vpn_session.test", "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyL2VpnSessionExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy L2 VPN Session resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy L2 VPN Session resource ID not set in resources")
+		}
+
+		isT0, gwID, localeServiceID, serviceID := parseVpnServicePolicyPath(rs.Primary.Attributes["service_path"], l2VpnServicesPathSegment)
+		exists, err := resourceNsxtPolicyL2VpnSessionExists(isT0, gwID, localeServiceID, serviceID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("L2 VPN Session ID %s does not exist on backend", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyL2VpnSessionCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_l2_vpn_session" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		isT0, gwID, localeServiceID, serviceID := parseVpnServicePolicyPath(rs.Primary.Attributes["service_path"], l2VpnServicesPathSegment)
+		exists, err := resourceNsxtPolicyL2VpnSessionExists(isT0, gwID, localeServiceID, serviceID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Policy L2 VPN Session %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyL2VpnSessionPrerequisites() string {
+	return testAccNsxtPolicyIPSecVpnSessionPrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_session" "test" {
+  service_path        = nsxt_policy_ipsec_vpn_service.test.path
+  local_endpoint_path = nsxt_policy_ipsec_vpn_local_endpoint.test.path
+  ike_profile_path    = nsxt_policy_ipsec_vpn_ike_profile.test.path
+  tunnel_profile_path = nsxt_policy_ipsec_vpn_tunnel_profile.test.path
+  display_name        = "%s"
+  peer_address        = "30.30.0.10"
+  peer_id             = "30.30.0.10"
+  psk                 = "secret1"
+  ip_addresses        = ["169.254.152.2"]
+  prefix_length       = 30
+}
+
+resource "nsxt_policy_l2_vpn_service" "test" {
+  locale_service_path = "${nsxt_policy_tier0_gateway.test.path}/locale-services/default"
+  display_name        = "%s"
+}`, accTestPolicyIPSecVpnServiceHelperName, accTestPolicyIPSecVpnServiceHelperName)
+}
+
+func testAccNsxtPolicyL2VpnSessionTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyL2VpnSessionCreateAttributes
+	} else {
+		attrMap = accTestPolicyL2VpnSessionUpdateAttributes
+	}
+	return testAccNsxtPolicyL2VpnSessionPrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_l2_vpn_session" "test" {
+  service_path      = nsxt_policy_l2_vpn_service.test.path
+  transport_tunnels = [nsxt_policy_ipsec_vpn_session.test.path]
+
+  display_name = "%s"
+  description  = "%s"
+  enabled      = %s
+
+  tcp_mss_clamping {
+    direction = "%s"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["enabled"], attrMap["tcp_mss_clamping.0.direction"])
+}
+
+func testAccNsxtPolicyL2VpnSessionMinimalistic() string {
+	return testAccNsxtPolicyL2VpnSessionPrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_l2_vpn_session" "test" {
+  service_path      = nsxt_policy_l2_vpn_service.test.path
+  transport_tunnels = [nsxt_policy_ipsec_vpn_session.test.path]
+  display_name      = "%s"
+}`, accTestPolicyL2VpnSessionUpdateAttributes["display_name"])
+}
+
+func TestResourceNsxtPolicyL2VpnSessionReadAndPatch(t *testing.T) {
+	tunnelPath := "/infra/tier-0s/gw0/locale-services/default/ipsec-vpn-services/vpn1/sessions/ipsec1"
+	sessionURL := "/policy/api/v1/infra/tier-0s/gw0/locale-services/default/l2vpn-services/l2vpn1/sessions/session1"
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == sessionURL:
+			err := json.NewDecoder(r.Body).Decode(&received)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{}`)
+		case r.Method == http.MethodGet && r.URL.Path == sessionURL:
+			fmt.Fprintf(w, `{"resource_type": "L2VPNSession", "id": "session1", "display_name": "l2", "_revision": 1, "enabled": true,
+			  "transport_tunnels": ["%s"], "tcp_mss_clamping": {"direction": "BOTH", "max_segment_size": 1400}}`, tunnelPath)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	m := nsxtClients{PolicyHTTPClient: server.Client(), Host: server.URL, PolicyEnforcementPoint: "default"}
+
+	d := schema.TestResourceDataRaw(t, resourceNsxtPolicyL2VpnSession().Schema, map[string]interface{}{
+		"service_path":      "/infra/tier-0s/gw0/locale-services/default/l2vpn-services/l2vpn1",
+		"transport_tunnels": []interface{}{tunnelPath},
+		"tcp_mss_clamping":  []interface{}{map[string]interface{}{"direction": "BOTH"}},
+	})
+	err := policyL2VpnSessionPatch(d, m, true, "gw0", "default", "l2vpn1", "session1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprintf("%v", received["transport_tunnels"]) != fmt.Sprintf("[%s]", tunnelPath) {
+		t.Errorf("Expected transport tunnels in payload, got %v", received)
+	}
+	clamping, ok := received["tcp_mss_clamping"].(map[string]interface{})
+	if !ok || clamping["direction"] != "BOTH" {
+		t.Errorf("Expected tcp_mss_clamping in payload, got %v", received)
+	}
+	if _, ok := clamping["max_segment_size"]; ok {
+		t.Errorf("Expected max_segment_size to be omitted when not set, got %v", clamping)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceNsxtPolicyL2VpnSession().Schema, map[string]interface{}{
+		"service_path": "/infra/tier-0s/gw0/locale-services/default/l2vpn-services/l2vpn1",
+	})
+	d.SetId("session1")
+	err = resourceNsxtPolicyL2VpnSessionRead(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("transport_tunnels.#").(int) != 1 || d.Get("transport_tunnels.0").(string) != tunnelPath {
+		t.Errorf("Expected transport tunnels to be restored, got %v", d.Get("transport_tunnels"))
+	}
+	if d.Get("tcp_mss_clamping.0.direction").(string) != "BOTH" || d.Get("tcp_mss_clamping.0.max_segment_size").(int) != 1400 {
+		t.Errorf("Expected tcp_mss_clamping to be restored, got %v", d.Get("tcp_mss_clamping"))
+	}
+}
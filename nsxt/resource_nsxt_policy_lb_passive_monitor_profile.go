@@ -0,0 +1,137 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBPassiveMonitorProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBPassiveMonitorProfileCreate,
+		Read:   resourceNsxtPolicyLBPassiveMonitorProfileRead,
+		Update: resourceNsxtPolicyLBPassiveMonitorProfileUpdate,
+		Delete: resourceNsxtPolicyLBMonitorProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"max_fails": {
+				Type:         schema.TypeInt,
+				Description:  "When the number of consecutive failures reaches this value, then the member is considered temporarily unavailable for a configurable period",
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "After this timeout period, the member is tried again for a new connection to see if it is available",
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func getPolicyLBPassiveMonitorProfileFromSchema(d *schema.ResourceData) model.LBPassiveMonitorProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	maxFails := int64(d.Get("max_fails").(int))
+	timeout := int64(d.Get("timeout").(int))
+
+	return model.LBPassiveMonitorProfile{
+		DisplayName:  &displayName,
+		Description:  &description,
+		Tags:         tags,
+		MaxFails:     &maxFails,
+		Timeout:      &timeout,
+		ResourceType: model.LBMonitorProfile_RESOURCE_TYPE_LBPASSIVEMONITORPROFILE,
+	}
+}
+
+func resourceNsxtPolicyLBPassiveMonitorProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBMonitorProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getPolicyLBPassiveMonitorProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating LBPassiveMonitorProfile with ID %s", id)
+	err = patchNsxtPolicyLBMonitorProfile(connector, id, obj, model.LBPassiveMonitorProfileBindingType())
+	if err != nil {
+		return handleCreateError("LBPassiveMonitorProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBPassiveMonitorProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBPassiveMonitorProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBPassiveMonitorProfile ID")
+	}
+
+	rawObj, err := readNsxtPolicyLBMonitorProfile(connector, id, model.LBPassiveMonitorProfileBindingType())
+	if err != nil {
+		return handleReadError(d, "LBPassiveMonitorProfile", id, err)
+	}
+	obj := rawObj.(model.LBPassiveMonitorProfile)
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("max_fails", obj.MaxFails)
+	d.Set("timeout", obj.Timeout)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBPassiveMonitorProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBPassiveMonitorProfile ID")
+	}
+
+	obj := getPolicyLBPassiveMonitorProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	log.Printf("[INFO] Updating LBPassiveMonitorProfile with ID %s", id)
+	err := patchNsxtPolicyLBMonitorProfile(connector, id, obj, model.LBPassiveMonitorProfileBindingType())
+	if err != nil {
+		return handleUpdateError("LBPassiveMonitorProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBPassiveMonitorProfileRead(d, m)
+}
@@ -0,0 +1,133 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBPassiveMonitorProfileCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"max_fails":    "3",
+	"timeout":      "10",
+}
+
+var accTestPolicyLBPassiveMonitorProfileUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"max_fails":    "7",
+	"timeout":      "20",
+}
+
+func TestAccResourceNsxtPolicyLBPassiveMonitorProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_passive_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBPassiveMonitorProfileCheckDestroy(state, accTestPolicyLBPassiveMonitorProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBPassiveMonitorProfileTemplate(true),
+				Check:  testAccNsxtPolicyLBPassiveMonitorProfileCheckAttributes(testResourceName, accTestPolicyLBPassiveMonitorProfileCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyLBPassiveMonitorProfileTemplate(false),
+				Check:  testAccNsxtPolicyLBPassiveMonitorProfileCheckAttributes(testResourceName, accTestPolicyLBPassiveMonitorProfileUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyLBPassiveMonitorProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBPassiveMonitorProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBPassiveMonitorProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_lb_passive_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBPassiveMonitorProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBPassiveMonitorProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBPassiveMonitorProfileCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyLBPassiveMonitorProfileExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyLBPassiveMonitorProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyLBMonitorProfileExists)
+}
+
+func testAccNsxtPolicyLBPassiveMonitorProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_lb_passive_monitor_profile", resourceNsxtPolicyLBMonitorProfileExists)
+}
+
+func testAccNsxtPolicyLBPassiveMonitorProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyLBPassiveMonitorProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyLBPassiveMonitorProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_passive_monitor_profile" "test" {
+  display_name = "%s"
+  description  = "%s"
+  max_fails    = %s
+  timeout      = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["max_fails"], attrMap["timeout"])
+}
+
+func testAccNsxtPolicyLBPassiveMonitorProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_passive_monitor_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBPassiveMonitorProfileUpdateAttributes["display_name"])
+}
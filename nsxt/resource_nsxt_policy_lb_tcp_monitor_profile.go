@@ -0,0 +1,167 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBTcpMonitorProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBTcpMonitorProfileCreate,
+		Read:   resourceNsxtPolicyLBTcpMonitorProfileRead,
+		Update: resourceNsxtPolicyLBTcpMonitorProfileUpdate,
+		Delete: resourceNsxtPolicyLBMonitorProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"fall_count":   getLbMonitorFallCountSchema(),
+			"interval":     getLbMonitorIntervalSchema(),
+			"monitor_port": {
+				Type:         schema.TypeInt,
+				Description:  "If the monitor port is specified, it would override pool member port setting for healthcheck",
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"rise_count": getLbMonitorRiseCountSchema(),
+			"timeout":    getLbMonitorTimeoutSchema(),
+			"receive": {
+				Type:        schema.TypeString,
+				Description: "Expected data, if specified, can be anywhere in the response and it has to be a string, regular expressions are not supported",
+				Optional:    true,
+			},
+			"send": {
+				Type:        schema.TypeString,
+				Description: getLbMonitorSendDescription("tcp"),
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func getPolicyLBTcpMonitorProfileFromSchema(d *schema.ResourceData) model.LBTcpMonitorProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	fallCount := int64(d.Get("fall_count").(int))
+	interval := int64(d.Get("interval").(int))
+	riseCount := int64(d.Get("rise_count").(int))
+	timeout := int64(d.Get("timeout").(int))
+	receive := d.Get("receive").(string)
+	send := d.Get("send").(string)
+
+	obj := model.LBTcpMonitorProfile{
+		DisplayName:  &displayName,
+		Description:  &description,
+		Tags:         tags,
+		FallCount:    &fallCount,
+		Interval:     &interval,
+		RiseCount:    &riseCount,
+		Timeout:      &timeout,
+		ResourceType: model.LBMonitorProfile_RESOURCE_TYPE_LBTCPMONITORPROFILE,
+	}
+
+	if monitorPort := d.Get("monitor_port").(int); monitorPort > 0 {
+		port := int64(monitorPort)
+		obj.MonitorPort = &port
+	}
+	if receive != "" {
+		obj.Receive = &receive
+	}
+	if send != "" {
+		obj.Send = &send
+	}
+
+	return obj
+}
+
+func resourceNsxtPolicyLBTcpMonitorProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBMonitorProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getPolicyLBTcpMonitorProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating LBTcpMonitorProfile with ID %s", id)
+	err = patchNsxtPolicyLBMonitorProfile(connector, id, obj, model.LBTcpMonitorProfileBindingType())
+	if err != nil {
+		return handleCreateError("LBTcpMonitorProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBTcpMonitorProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBTcpMonitorProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBTcpMonitorProfile ID")
+	}
+
+	rawObj, err := readNsxtPolicyLBMonitorProfile(connector, id, model.LBTcpMonitorProfileBindingType())
+	if err != nil {
+		return handleReadError(d, "LBTcpMonitorProfile", id, err)
+	}
+	obj := rawObj.(model.LBTcpMonitorProfile)
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("fall_count", obj.FallCount)
+	d.Set("interval", obj.Interval)
+	d.Set("monitor_port", obj.MonitorPort)
+	d.Set("rise_count", obj.RiseCount)
+	d.Set("timeout", obj.Timeout)
+	d.Set("receive", obj.Receive)
+	d.Set("send", obj.Send)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBTcpMonitorProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBTcpMonitorProfile ID")
+	}
+
+	obj := getPolicyLBTcpMonitorProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	log.Printf("[INFO] Updating LBTcpMonitorProfile with ID %s", id)
+	err := patchNsxtPolicyLBMonitorProfile(connector, id, obj, model.LBTcpMonitorProfileBindingType())
+	if err != nil {
+		return handleUpdateError("LBTcpMonitorProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBTcpMonitorProfileRead(d, m)
+}
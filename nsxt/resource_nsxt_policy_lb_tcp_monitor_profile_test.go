@@ -0,0 +1,148 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBTcpMonitorProfileCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"fall_count":   "2",
+	"interval":     "10",
+	"monitor_port": "8080",
+	"rise_count":   "2",
+	"timeout":      "20",
+	"receive":      "pong",
+	"send":         "ping",
+}
+
+var accTestPolicyLBTcpMonitorProfileUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"fall_count":   "4",
+	"interval":     "7",
+	"monitor_port": "8090",
+	"rise_count":   "5",
+	"timeout":      "10",
+	"receive":      "ack",
+	"send":         "syn",
+}
+
+func TestAccResourceNsxtPolicyLBTcpMonitorProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_tcp_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBTcpMonitorProfileCheckDestroy(state, accTestPolicyLBTcpMonitorProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBTcpMonitorProfileTemplate(true),
+				Check:  testAccNsxtPolicyLBTcpMonitorProfileCheckAttributes(testResourceName, accTestPolicyLBTcpMonitorProfileCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyLBTcpMonitorProfileTemplate(false),
+				Check:  testAccNsxtPolicyLBTcpMonitorProfileCheckAttributes(testResourceName, accTestPolicyLBTcpMonitorProfileUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyLBTcpMonitorProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBTcpMonitorProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBTcpMonitorProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_lb_tcp_monitor_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBTcpMonitorProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBTcpMonitorProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBTcpMonitorProfileCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyLBTcpMonitorProfileExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyLBTcpMonitorProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyLBMonitorProfileExists)
+}
+
+func testAccNsxtPolicyLBTcpMonitorProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_lb_tcp_monitor_profile", resourceNsxtPolicyLBMonitorProfileExists)
+}
+
+func testAccNsxtPolicyLBTcpMonitorProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyLBTcpMonitorProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyLBTcpMonitorProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_tcp_monitor_profile" "test" {
+  display_name = "%s"
+  description  = "%s"
+  fall_count   = %s
+  interval     = %s
+  monitor_port = %s
+  rise_count   = %s
+  timeout      = %s
+  receive      = "%s"
+  send         = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["fall_count"], attrMap["interval"], attrMap["monitor_port"], attrMap["rise_count"], attrMap["timeout"], attrMap["receive"], attrMap["send"])
+}
+
+func testAccNsxtPolicyLBTcpMonitorProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_tcp_monitor_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBTcpMonitorProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,268 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var macDiscoveryProfileMacLimitPolicyValues = []string{
+	model.MacDiscoveryProfile_MAC_LIMIT_POLICY_ALLOW,
+	model.MacDiscoveryProfile_MAC_LIMIT_POLICY_DROP,
+}
+
+func resourceNsxtPolicyMacDiscoveryProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyMacDiscoveryProfileCreate,
+		Read:   resourceNsxtPolicyMacDiscoveryProfileRead,
+		Update: resourceNsxtPolicyMacDiscoveryProfileUpdate,
+		Delete: resourceNsxtPolicyMacDiscoveryProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"mac_change_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Is MAC change enabled or not",
+				Optional:    true,
+				Default:     false,
+			},
+			"mac_learning_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Is MAC learning enabled or not",
+				Optional:    true,
+				Default:     false,
+			},
+			"mac_learning_aging_time": {
+				Type:         schema.TypeInt,
+				Description:  "How long learned MAC address remain (in seconds)",
+				Optional:     true,
+				Default:      600,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"mac_limit": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of MAC addresses that can be learned on this port",
+				Optional:     true,
+				Default:      4096,
+				ValidateFunc: validation.IntBetween(0, 4096),
+			},
+			"mac_limit_policy": {
+				Type:         schema.TypeString,
+				Description:  "The policy after MAC limit is exceeded",
+				Optional:     true,
+				Default:      model.MacDiscoveryProfile_MAC_LIMIT_POLICY_ALLOW,
+				ValidateFunc: validation.StringInSlice(macDiscoveryProfileMacLimitPolicyValues, false),
+			},
+			"remote_overlay_mac_limit": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of MAC addresses learned for a remote virtual machine's MAC to VTEP binding per overlay logical switch",
+				Optional:     true,
+				Default:      2048,
+				ValidateFunc: validation.IntBetween(2048, 8192),
+			},
+			"unknown_unicast_flooding_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Allowing flooding for unlearned MAC for ingress traffic",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyMacDiscoveryProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDefaultMacDiscoveryProfilesClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewDefaultMacDiscoveryProfilesClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getMacDiscoveryProfileFromSchema(d *schema.ResourceData) model.MacDiscoveryProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+
+	macChangeEnabled := d.Get("mac_change_enabled").(bool)
+	macLearningEnabled := d.Get("mac_learning_enabled").(bool)
+	macLearningAgingTime := int64(d.Get("mac_learning_aging_time").(int))
+	macLimit := int64(d.Get("mac_limit").(int))
+	macLimitPolicy := d.Get("mac_limit_policy").(string)
+	remoteOverlayMacLimit := int64(d.Get("remote_overlay_mac_limit").(int))
+	unknownUnicastFloodingEnabled := d.Get("unknown_unicast_flooding_enabled").(bool)
+
+	return model.MacDiscoveryProfile{
+		DisplayName:                   &displayName,
+		Description:                   &description,
+		Tags:                          tags,
+		MacChangeEnabled:              &macChangeEnabled,
+		MacLearningEnabled:            &macLearningEnabled,
+		MacLearningAgingTime:          &macLearningAgingTime,
+		MacLimit:                      &macLimit,
+		MacLimitPolicy:                &macLimitPolicy,
+		RemoteOverlayMacLimit:         &remoteOverlayMacLimit,
+		UnknownUnicastFloodingEnabled: &unknownUnicastFloodingEnabled,
+	}
+}
+
+func patchNsxtPolicyMacDiscoveryProfile(connector *client.RestConnector, id string, obj model.MacDiscoveryProfile, isGlobalManager bool) error {
+	boolFalse := false
+	if isGlobalManager {
+		gmObj, err := convertModelBindingType(obj, model.MacDiscoveryProfileBindingType(), gm_model.MacDiscoveryProfileBindingType())
+		if err != nil {
+			return err
+		}
+
+		client := gm_infra.NewDefaultMacDiscoveryProfilesClient(connector)
+		return client.Patch(id, gmObj.(gm_model.MacDiscoveryProfile), &boolFalse)
+	}
+
+	client := infra.NewDefaultMacDiscoveryProfilesClient(connector)
+	return client.Patch(id, obj, &boolFalse)
+}
+
+func resourceNsxtPolicyMacDiscoveryProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyMacDiscoveryProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getMacDiscoveryProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating MacDiscoveryProfile with ID %s", id)
+	err = patchNsxtPolicyMacDiscoveryProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleCreateError("MacDiscoveryProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyMacDiscoveryProfileRead(d, m)
+}
+
+func resourceNsxtPolicyMacDiscoveryProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining MacDiscoveryProfile ID")
+	}
+
+	var obj model.MacDiscoveryProfile
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultMacDiscoveryProfilesClient(connector)
+		gmObj, err := client.Get(id)
+		if err != nil {
+			return handleReadError(d, "MacDiscoveryProfile", id, err)
+		}
+		rawObj, err := convertModelBindingType(gmObj, gm_model.MacDiscoveryProfileBindingType(), model.MacDiscoveryProfileBindingType())
+		if err != nil {
+			return err
+		}
+		obj = rawObj.(model.MacDiscoveryProfile)
+	} else {
+		var err error
+		client := infra.NewDefaultMacDiscoveryProfilesClient(connector)
+		obj, err = client.Get(id)
+		if err != nil {
+			return handleReadError(d, "MacDiscoveryProfile", id, err)
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("mac_change_enabled", obj.MacChangeEnabled)
+	d.Set("mac_learning_enabled", obj.MacLearningEnabled)
+	d.Set("mac_learning_aging_time", obj.MacLearningAgingTime)
+	d.Set("mac_limit", obj.MacLimit)
+	d.Set("mac_limit_policy", obj.MacLimitPolicy)
+	d.Set("remote_overlay_mac_limit", obj.RemoteOverlayMacLimit)
+	d.Set("unknown_unicast_flooding_enabled", obj.UnknownUnicastFloodingEnabled)
+
+	return nil
+}
+
+func resourceNsxtPolicyMacDiscoveryProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining MacDiscoveryProfile ID")
+	}
+
+	obj := getMacDiscoveryProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	log.Printf("[INFO] Updating MacDiscoveryProfile with ID %s", id)
+	err := patchNsxtPolicyMacDiscoveryProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleUpdateError("MacDiscoveryProfile", id, err)
+	}
+
+	return resourceNsxtPolicyMacDiscoveryProfileRead(d, m)
+}
+
+func resourceNsxtPolicyMacDiscoveryProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining MacDiscoveryProfile ID")
+	}
+
+	var err error
+	connector := getPolicyConnector(m)
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultMacDiscoveryProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	} else {
+		client := infra.NewDefaultMacDiscoveryProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	}
+
+	if err != nil {
+		return handleDeleteError("MacDiscoveryProfile", id, err)
+	}
+
+	return nil
+}
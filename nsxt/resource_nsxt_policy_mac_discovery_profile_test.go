@@ -0,0 +1,151 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyMacDiscoveryProfileCreateAttributes = map[string]string{
+	"display_name":                     getAccTestResourceName(),
+	"description":                      "terraform created",
+	"mac_change_enabled":               "true",
+	"mac_learning_enabled":             "true",
+	"mac_learning_aging_time":          "300",
+	"mac_limit":                        "1024",
+	"mac_limit_policy":                 "ALLOW",
+	"remote_overlay_mac_limit":         "4096",
+	"unknown_unicast_flooding_enabled": "true",
+}
+
+var accTestPolicyMacDiscoveryProfileUpdateAttributes = map[string]string{
+	"display_name":                     getAccTestResourceName(),
+	"description":                      "terraform updated",
+	"mac_change_enabled":               "false",
+	"mac_learning_enabled":             "true",
+	"mac_learning_aging_time":          "900",
+	"mac_limit":                        "2048",
+	"mac_limit_policy":                 "DROP",
+	"remote_overlay_mac_limit":         "2048",
+	"unknown_unicast_flooding_enabled": "false",
+}
+
+func TestAccResourceNsxtPolicyMacDiscoveryProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_mac_discovery_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMacDiscoveryProfileCheckDestroy(state, accTestPolicyMacDiscoveryProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMacDiscoveryProfileTemplate(true),
+				Check:  testAccNsxtPolicyMacDiscoveryProfileCheckAttributes(testResourceName, accTestPolicyMacDiscoveryProfileCreateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyMacDiscoveryProfileTemplate(false),
+				Check:  testAccNsxtPolicyMacDiscoveryProfileCheckAttributes(testResourceName, accTestPolicyMacDiscoveryProfileUpdateAttributes, "1"),
+			},
+			{
+				Config: testAccNsxtPolicyMacDiscoveryProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMacDiscoveryProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "mac_limit", "4096"),
+					resource.TestCheckResourceAttr(testResourceName, "unknown_unicast_flooding_enabled", "true"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyMacDiscoveryProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_mac_discovery_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMacDiscoveryProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMacDiscoveryProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyMacDiscoveryProfileCheckAttributes(resourceName string, attrMap map[string]string, tagCount string) resource.TestCheckFunc {
+	checks := []resource.TestCheckFunc{
+		testAccNsxtPolicyMacDiscoveryProfileExists(resourceName),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", tagCount),
+	}
+	for key, value := range attrMap {
+		checks = append(checks, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+
+	return resource.ComposeTestCheckFunc(checks...)
+}
+
+func testAccNsxtPolicyMacDiscoveryProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyMacDiscoveryProfileExists)
+}
+
+func testAccNsxtPolicyMacDiscoveryProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_mac_discovery_profile", resourceNsxtPolicyMacDiscoveryProfileExists)
+}
+
+func testAccNsxtPolicyMacDiscoveryProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyMacDiscoveryProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyMacDiscoveryProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_mac_discovery_profile" "test" {
+  display_name = "%s"
+  description  = "%s"
+
+  mac_change_enabled               = %s
+  mac_learning_enabled             = %s
+  mac_learning_aging_time          = %s
+  mac_limit                        = %s
+  mac_limit_policy                 = "%s"
+  remote_overlay_mac_limit         = %s
+  unknown_unicast_flooding_enabled = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["mac_change_enabled"], attrMap["mac_learning_enabled"], attrMap["mac_learning_aging_time"], attrMap["mac_limit"], attrMap["mac_limit_policy"], attrMap["remote_overlay_mac_limit"], attrMap["unknown_unicast_flooding_enabled"])
+}
+
+func testAccNsxtPolicyMacDiscoveryProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_mac_discovery_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyMacDiscoveryProfileUpdateAttributes["display_name"])
+}
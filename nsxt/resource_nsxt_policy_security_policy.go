@@ -107,6 +107,12 @@ func resourceNsxtPolicySecurityPolicyCreate(d *schema.ResourceData, m interface{
 		TcpStrict:      &tcpStrict,
 		Rules:          rules,
 	}
+
+	obj.SchedulerPath, err = getPolicySchedulerPathFromSchema(d)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Creating Security Policy with ID %s", id)
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.SecurityPolicyBindingType(), gm_model.SecurityPolicyBindingType())
@@ -174,6 +180,7 @@ func resourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{})
 	d.Set("sequence_number", obj.SequenceNumber)
 	d.Set("stateful", obj.Stateful)
 	d.Set("tcp_strict", obj.TcpStrict)
+	d.Set("scheduler_path", obj.SchedulerPath)
 	d.Set("revision", obj.Revision)
 	return setPolicyRulesInSchema(d, obj.Rules)
 }
@@ -197,7 +204,7 @@ func resourceNsxtPolicySecurityPolicyUpdate(d *schema.ResourceData, m interface{
 	stateful := d.Get("stateful").(bool)
 	tcpStrict := d.Get("tcp_strict").(bool)
 	rules := getPolicyRulesFromSchema(d, false)
-	revision := int64(d.Get("revision").(int))
+	domain := d.Get("domain").(string)
 
 	obj := model.SecurityPolicy{
 		DisplayName:    &displayName,
@@ -210,29 +217,47 @@ func resourceNsxtPolicySecurityPolicyUpdate(d *schema.ResourceData, m interface{
 		SequenceNumber: &sequenceNumber,
 		Stateful:       &stateful,
 		TcpStrict:      &tcpStrict,
-		Revision:       &revision,
 		Rules:          rules,
 	}
 
-	var err error
-	if isPolicyGlobalManager(m) {
-		gmObj, err1 := convertModelBindingType(obj, model.SecurityPolicyBindingType(), gm_model.SecurityPolicyBindingType())
-		if err1 != nil {
-			return err1
+	schedulerPath, err := getPolicySchedulerPathFromSchema(d)
+	if err != nil {
+		return err
+	}
+	obj.SchedulerPath = schedulerPath
+
+	doUpdate := func(revision int64) error {
+		obj.Revision = &revision
+		if isPolicyGlobalManager(m) {
+			gmObj, err := convertModelBindingType(obj, model.SecurityPolicyBindingType(), gm_model.SecurityPolicyBindingType())
+			if err != nil {
+				return err
+			}
+			gmSecurityPolicy := gmObj.(gm_model.SecurityPolicy)
+			client := gm_domains.NewDefaultSecurityPoliciesClient(connector)
+
+			// We need to use PUT, because PATCH will not replace the whole rule list
+			_, err = client.Update(domain, id, gmSecurityPolicy)
+			return err
 		}
-		gmSecurityPolicy := gmObj.(gm_model.SecurityPolicy)
-		client := gm_domains.NewDefaultSecurityPoliciesClient(connector)
-
-		// We need to use PUT, because PATCH will not replace the whole rule list
-		_, err = client.Update(d.Get("domain").(string), id, gmSecurityPolicy)
-	} else {
 		client := domains.NewDefaultSecurityPoliciesClient(connector)
 
 		// We need to use PUT, because PATCH will not replace the whole rule list
-		_, err = client.Update(d.Get("domain").(string), id, obj)
+		_, err := client.Update(domain, id, obj)
+		return err
+	}
+
+	getRevision := func() (int64, error) {
+		policy, err := getSecurityPolicyInDomain(id, domain, connector, isPolicyGlobalManager(m))
+		if err != nil {
+			return 0, err
+		}
+		return *policy.Revision, nil
 	}
+
+	err = updateUponRevisionConflict(d, doUpdate, getRevision)
 	if err != nil {
-		return handleUpdateError("Security Policy", id, err)
+		return handleRevisionConflictError("Security Policy", id, err)
 	}
 
 	return resourceNsxtPolicySecurityPolicyRead(d, m)
@@ -384,9 +384,10 @@ func TestAccResourceNsxtPolicySecurityPolicy_importBasic(t *testing.T) {
 				Config: testAccNsxtPolicySecurityPolicyBasic(name, "import", defaultDomain),
 			},
 			{
-				ResourceName:      testResourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"refresh_on_revision_conflict"},
 			},
 		},
 	})
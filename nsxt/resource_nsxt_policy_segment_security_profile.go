@@ -0,0 +1,350 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicySegmentSecurityProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicySegmentSecurityProfileCreate,
+		Read:   resourceNsxtPolicySegmentSecurityProfileRead,
+		Update: resourceNsxtPolicySegmentSecurityProfileUpdate,
+		Delete: resourceNsxtPolicySegmentSecurityProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"bpdu_filter_allow": {
+				Type:        schema.TypeSet,
+				Description: "Allowed BPDU filter MAC addresses",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"bpdu_filter_enable": {
+				Type:        schema.TypeBool,
+				Description: "Indicates whether BPDU filter is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"dhcp_client_block_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Filter DHCP Server and/or DHCP Client traffic",
+				Optional:    true,
+				Default:     false,
+			},
+			"dhcp_client_block_v6_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Filter DHCP Server and/or DHCP Client IPv6 traffic",
+				Optional:    true,
+				Default:     false,
+			},
+			"dhcp_server_block_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Filter DHCP Server and/or DHCP Client traffic",
+				Optional:    true,
+				Default:     true,
+			},
+			"dhcp_server_block_v6_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Filter DHCP Server and/or DHCP Client IPv6 traffic",
+				Optional:    true,
+				Default:     true,
+			},
+			"non_ip_traffic_block_enabled": {
+				Type:        schema.TypeBool,
+				Description: "A flag to block all traffic except IP/(G)ARP/BPDU",
+				Optional:    true,
+				Default:     false,
+			},
+			"ra_guard_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable or disable Router Advertisement Guard",
+				Optional:    true,
+				Default:     true,
+			},
+			"rate_limits_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable or disable Rate Limits",
+				Optional:    true,
+				Default:     false,
+			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Description: "Allowed traffic rate limits",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rx_broadcast": {
+							Type:        schema.TypeInt,
+							Description: "Incoming broadcast traffic limit in packets per second",
+							Optional:    true,
+						},
+						"rx_multicast": {
+							Type:        schema.TypeInt,
+							Description: "Incoming multicast traffic limit in packets per second",
+							Optional:    true,
+						},
+						"tx_broadcast": {
+							Type:        schema.TypeInt,
+							Description: "Outgoing broadcast traffic limit in packets per second",
+							Optional:    true,
+						},
+						"tx_multicast": {
+							Type:        schema.TypeInt,
+							Description: "Outgoing multicast traffic limit in packets per second",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicySegmentSecurityProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDefaultSegmentSecurityProfilesClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewDefaultSegmentSecurityProfilesClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getSegmentSecurityProfileRateLimitsFromSchema(d *schema.ResourceData) *model.TrafficRateLimits {
+	limits := d.Get("rate_limit").([]interface{})
+	for _, item := range limits {
+		if item == nil {
+			continue
+		}
+		data := item.(map[string]interface{})
+		rxBroadcast := int64(data["rx_broadcast"].(int))
+		rxMulticast := int64(data["rx_multicast"].(int))
+		txBroadcast := int64(data["tx_broadcast"].(int))
+		txMulticast := int64(data["tx_multicast"].(int))
+		return &model.TrafficRateLimits{
+			RxBroadcast: &rxBroadcast,
+			RxMulticast: &rxMulticast,
+			TxBroadcast: &txBroadcast,
+			TxMulticast: &txMulticast,
+		}
+	}
+
+	return nil
+}
+
+func setSegmentSecurityProfileRateLimitsInSchema(d *schema.ResourceData, limits *model.TrafficRateLimits) {
+	var result []interface{}
+	if limits != nil {
+		elem := make(map[string]interface{})
+		elem["rx_broadcast"] = limits.RxBroadcast
+		elem["rx_multicast"] = limits.RxMulticast
+		elem["tx_broadcast"] = limits.TxBroadcast
+		elem["tx_multicast"] = limits.TxMulticast
+		result = append(result, elem)
+	}
+
+	err := d.Set("rate_limit", result)
+	if err != nil {
+		log.Printf("[WARNING] Failed to set rate limits in schema: %v", err)
+	}
+}
+
+func getSegmentSecurityProfileFromSchema(d *schema.ResourceData) model.SegmentSecurityProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+
+	bpduFilterAllow := getStringListFromSchemaSet(d, "bpdu_filter_allow")
+	bpduFilterEnable := d.Get("bpdu_filter_enable").(bool)
+	dhcpClientBlockEnabled := d.Get("dhcp_client_block_enabled").(bool)
+	dhcpClientBlockV6Enabled := d.Get("dhcp_client_block_v6_enabled").(bool)
+	dhcpServerBlockEnabled := d.Get("dhcp_server_block_enabled").(bool)
+	dhcpServerBlockV6Enabled := d.Get("dhcp_server_block_v6_enabled").(bool)
+	nonIPTrafficBlockEnabled := d.Get("non_ip_traffic_block_enabled").(bool)
+	raGuardEnabled := d.Get("ra_guard_enabled").(bool)
+	rateLimitsEnabled := d.Get("rate_limits_enabled").(bool)
+
+	return model.SegmentSecurityProfile{
+		DisplayName:              &displayName,
+		Description:              &description,
+		Tags:                     tags,
+		BpduFilterAllow:          bpduFilterAllow,
+		BpduFilterEnable:         &bpduFilterEnable,
+		DhcpClientBlockEnabled:   &dhcpClientBlockEnabled,
+		DhcpClientBlockV6Enabled: &dhcpClientBlockV6Enabled,
+		DhcpServerBlockEnabled:   &dhcpServerBlockEnabled,
+		DhcpServerBlockV6Enabled: &dhcpServerBlockV6Enabled,
+		NonIpTrafficBlockEnabled: &nonIPTrafficBlockEnabled,
+		RaGuardEnabled:           &raGuardEnabled,
+		RateLimitsEnabled:        &rateLimitsEnabled,
+		RateLimits:               getSegmentSecurityProfileRateLimitsFromSchema(d),
+	}
+}
+
+func patchNsxtPolicySegmentSecurityProfile(connector *client.RestConnector, id string, obj model.SegmentSecurityProfile, isGlobalManager bool) error {
+	boolFalse := false
+	if isGlobalManager {
+		gmObj, err := convertModelBindingType(obj, model.SegmentSecurityProfileBindingType(), gm_model.SegmentSecurityProfileBindingType())
+		if err != nil {
+			return err
+		}
+
+		client := gm_infra.NewDefaultSegmentSecurityProfilesClient(connector)
+		return client.Patch(id, gmObj.(gm_model.SegmentSecurityProfile), &boolFalse)
+	}
+
+	client := infra.NewDefaultSegmentSecurityProfilesClient(connector)
+	return client.Patch(id, obj, &boolFalse)
+}
+
+func resourceNsxtPolicySegmentSecurityProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicySegmentSecurityProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getSegmentSecurityProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating SegmentSecurityProfile with ID %s", id)
+	err = patchNsxtPolicySegmentSecurityProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleCreateError("SegmentSecurityProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicySegmentSecurityProfileRead(d, m)
+}
+
+func resourceNsxtPolicySegmentSecurityProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SegmentSecurityProfile ID")
+	}
+
+	var obj model.SegmentSecurityProfile
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultSegmentSecurityProfilesClient(connector)
+		gmObj, err := client.Get(id)
+		if err != nil {
+			return handleReadError(d, "SegmentSecurityProfile", id, err)
+		}
+		rawObj, err := convertModelBindingType(gmObj, gm_model.SegmentSecurityProfileBindingType(), model.SegmentSecurityProfileBindingType())
+		if err != nil {
+			return err
+		}
+		obj = rawObj.(model.SegmentSecurityProfile)
+	} else {
+		var err error
+		client := infra.NewDefaultSegmentSecurityProfilesClient(connector)
+		obj, err = client.Get(id)
+		if err != nil {
+			return handleReadError(d, "SegmentSecurityProfile", id, err)
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("bpdu_filter_allow", obj.BpduFilterAllow)
+	d.Set("bpdu_filter_enable", obj.BpduFilterEnable)
+	d.Set("dhcp_client_block_enabled", obj.DhcpClientBlockEnabled)
+	d.Set("dhcp_client_block_v6_enabled", obj.DhcpClientBlockV6Enabled)
+	d.Set("dhcp_server_block_enabled", obj.DhcpServerBlockEnabled)
+	d.Set("dhcp_server_block_v6_enabled", obj.DhcpServerBlockV6Enabled)
+	d.Set("non_ip_traffic_block_enabled", obj.NonIpTrafficBlockEnabled)
+	d.Set("ra_guard_enabled", obj.RaGuardEnabled)
+	d.Set("rate_limits_enabled", obj.RateLimitsEnabled)
+	setSegmentSecurityProfileRateLimitsInSchema(d, obj.RateLimits)
+
+	return nil
+}
+
+func resourceNsxtPolicySegmentSecurityProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SegmentSecurityProfile ID")
+	}
+
+	obj := getSegmentSecurityProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	log.Printf("[INFO] Updating SegmentSecurityProfile with ID %s", id)
+	err := patchNsxtPolicySegmentSecurityProfile(connector, id, obj, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleUpdateError("SegmentSecurityProfile", id, err)
+	}
+
+	return resourceNsxtPolicySegmentSecurityProfileRead(d, m)
+}
+
+func resourceNsxtPolicySegmentSecurityProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SegmentSecurityProfile ID")
+	}
+
+	var err error
+	connector := getPolicyConnector(m)
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDefaultSegmentSecurityProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	} else {
+		client := infra.NewDefaultSegmentSecurityProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	}
+
+	if err != nil {
+		return handleDeleteError("SegmentSecurityProfile", id, err)
+	}
+
+	return nil
+}
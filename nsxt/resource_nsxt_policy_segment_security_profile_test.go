@@ -0,0 +1,180 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicySegmentSecurityProfileCreateAttributes = map[string]string{
+	"display_name":                 getAccTestResourceName(),
+	"description":                  "terraform created",
+	"bpdu_filter_enable":           "true",
+	"dhcp_client_block_enabled":    "true",
+	"dhcp_client_block_v6_enabled": "true",
+	"dhcp_server_block_enabled":    "true",
+	"dhcp_server_block_v6_enabled": "true",
+	"non_ip_traffic_block_enabled": "true",
+	"ra_guard_enabled":             "true",
+	"rate_limits_enabled":          "true",
+	"rx_broadcast":                 "10",
+	"rx_multicast":                 "20",
+	"tx_broadcast":                 "30",
+	"tx_multicast":                 "40",
+}
+
+var accTestPolicySegmentSecurityProfileUpdateAttributes = map[string]string{
+	"display_name":                 getAccTestResourceName(),
+	"description":                  "terraform updated",
+	"bpdu_filter_enable":           "false",
+	"dhcp_client_block_enabled":    "false",
+	"dhcp_client_block_v6_enabled": "false",
+	"dhcp_server_block_enabled":    "false",
+	"dhcp_server_block_v6_enabled": "false",
+	"non_ip_traffic_block_enabled": "false",
+	"ra_guard_enabled":             "false",
+	"rate_limits_enabled":          "false",
+	"rx_broadcast":                 "100",
+	"rx_multicast":                 "200",
+	"tx_broadcast":                 "300",
+	"tx_multicast":                 "400",
+}
+
+func TestAccResourceNsxtPolicySegmentSecurityProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_segment_security_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySegmentSecurityProfileCheckDestroy(state, accTestPolicySegmentSecurityProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentSecurityProfileTemplate(true),
+				Check:  testAccNsxtPolicySegmentSecurityProfileCheckAttributes(testResourceName, accTestPolicySegmentSecurityProfileCreateAttributes),
+			},
+			{
+				Config: testAccNsxtPolicySegmentSecurityProfileTemplate(false),
+				Check:  testAccNsxtPolicySegmentSecurityProfileCheckAttributes(testResourceName, accTestPolicySegmentSecurityProfileUpdateAttributes),
+			},
+			{
+				Config: testAccNsxtPolicySegmentSecurityProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySegmentSecurityProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "bpdu_filter_allow.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySegmentSecurityProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_segment_security_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySegmentSecurityProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentSecurityProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentSecurityProfileCheckAttributes(resourceName string, attrMap map[string]string) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		testAccNsxtPolicySegmentSecurityProfileExists(resourceName),
+		resource.TestCheckResourceAttr(resourceName, "display_name", attrMap["display_name"]),
+		resource.TestCheckResourceAttr(resourceName, "description", attrMap["description"]),
+		resource.TestCheckResourceAttr(resourceName, "bpdu_filter_allow.#", "1"),
+		resource.TestCheckResourceAttr(resourceName, "bpdu_filter_enable", attrMap["bpdu_filter_enable"]),
+		resource.TestCheckResourceAttr(resourceName, "dhcp_client_block_enabled", attrMap["dhcp_client_block_enabled"]),
+		resource.TestCheckResourceAttr(resourceName, "dhcp_client_block_v6_enabled", attrMap["dhcp_client_block_v6_enabled"]),
+		resource.TestCheckResourceAttr(resourceName, "dhcp_server_block_enabled", attrMap["dhcp_server_block_enabled"]),
+		resource.TestCheckResourceAttr(resourceName, "dhcp_server_block_v6_enabled", attrMap["dhcp_server_block_v6_enabled"]),
+		resource.TestCheckResourceAttr(resourceName, "non_ip_traffic_block_enabled", attrMap["non_ip_traffic_block_enabled"]),
+		resource.TestCheckResourceAttr(resourceName, "ra_guard_enabled", attrMap["ra_guard_enabled"]),
+		resource.TestCheckResourceAttr(resourceName, "rate_limits_enabled", attrMap["rate_limits_enabled"]),
+		resource.TestCheckResourceAttr(resourceName, "rate_limit.#", "1"),
+		resource.TestCheckResourceAttr(resourceName, "rate_limit.0.rx_broadcast", attrMap["rx_broadcast"]),
+		resource.TestCheckResourceAttr(resourceName, "rate_limit.0.rx_multicast", attrMap["rx_multicast"]),
+		resource.TestCheckResourceAttr(resourceName, "rate_limit.0.tx_broadcast", attrMap["tx_broadcast"]),
+		resource.TestCheckResourceAttr(resourceName, "rate_limit.0.tx_multicast", attrMap["tx_multicast"]),
+		resource.TestCheckResourceAttrSet(resourceName, "nsx_id"),
+		resource.TestCheckResourceAttrSet(resourceName, "path"),
+		resource.TestCheckResourceAttrSet(resourceName, "revision"),
+		resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+	)
+}
+
+func testAccNsxtPolicySegmentSecurityProfileExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicySegmentSecurityProfileExists)
+}
+
+func testAccNsxtPolicySegmentSecurityProfileCheckDestroy(state *terraform.State, displayName string) error {
+	return testAccNsxtPolicyResourceCheckDestroy(state, displayName, "nsxt_policy_segment_security_profile", resourceNsxtPolicySegmentSecurityProfileExists)
+}
+
+func testAccNsxtPolicySegmentSecurityProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicySegmentSecurityProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicySegmentSecurityProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_segment_security_profile" "test" {
+  display_name = "%s"
+  description  = "%s"
+
+  bpdu_filter_allow            = ["01:80:c2:00:00:01"]
+  bpdu_filter_enable           = %s
+  dhcp_client_block_enabled    = %s
+  dhcp_client_block_v6_enabled = %s
+  dhcp_server_block_enabled    = %s
+  dhcp_server_block_v6_enabled = %s
+  non_ip_traffic_block_enabled = %s
+  ra_guard_enabled             = %s
+  rate_limits_enabled          = %s
+
+  rate_limit {
+    rx_broadcast = %s
+    rx_multicast = %s
+    tx_broadcast = %s
+    tx_multicast = %s
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["bpdu_filter_enable"], attrMap["dhcp_client_block_enabled"], attrMap["dhcp_client_block_v6_enabled"], attrMap["dhcp_server_block_enabled"], attrMap["dhcp_server_block_v6_enabled"], attrMap["non_ip_traffic_block_enabled"], attrMap["ra_guard_enabled"], attrMap["rate_limits_enabled"], attrMap["rx_broadcast"], attrMap["rx_multicast"], attrMap["tx_broadcast"], attrMap["tx_multicast"])
+}
+
+func testAccNsxtPolicySegmentSecurityProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_segment_security_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicySegmentSecurityProfileUpdateAttributes["display_name"])
+}
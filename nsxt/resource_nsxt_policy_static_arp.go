@@ -0,0 +1,172 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/segments"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyStaticArp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyStaticArpCreate,
+		Read:   resourceNsxtPolicyStaticArpRead,
+		Update: resourceNsxtPolicyStaticArpUpdate,
+		Delete: resourceNsxtPolicyStaticArpDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyStaticArpImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"segment_path": getPolicyPathSchema(true, true, "Policy path for the Tier1 fixed segment"),
+			"ip_address": {
+				Type:         schema.TypeString,
+				Description:  "IP address of the static ARP entry",
+				Required:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"mac_address": {
+				Type:         schema.TypeString,
+				Description:  "MAC address of the static ARP entry",
+				Required:     true,
+				ValidateFunc: validateMAC(),
+			},
+		},
+	}
+}
+
+// Static ARP is configured per Tier1 fixed segment
+func parseStaticArpSegmentPath(segmentPath string) (string, string, error) {
+	isT0, gwID, segmentID := parseSegmentPolicyPath(segmentPath)
+	if segmentID == "" || gwID == "" || isT0 {
+		return "", "", fmt.Errorf("Tier1 fixed segment path expected, got %s", segmentPath)
+	}
+
+	return gwID, segmentID, nil
+}
+
+func patchNsxtPolicyStaticArp(d *schema.ResourceData, m interface{}, gwID string, segmentID string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	ipAddress := d.Get("ip_address").(string)
+	macAddress := d.Get("mac_address").(string)
+
+	obj := model.StaticARPConfig{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		IpAddress:   &ipAddress,
+		MacAddress:  &macAddress,
+	}
+
+	client := segments.NewDefaultStaticArpClient(connector)
+	return client.Patch(gwID, segmentID, obj)
+}
+
+func resourceNsxtPolicyStaticArpCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	segmentPath := d.Get("segment_path").(string)
+	gwID, segmentID, err := parseStaticArpSegmentPath(segmentPath)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Static ARP for Segment %s", segmentPath)
+	err = patchNsxtPolicyStaticArp(d, m, gwID, segmentID)
+	if err != nil {
+		return handleCreateError("Static ARP", segmentID, err)
+	}
+
+	d.SetId(segmentID)
+
+	return resourceNsxtPolicyStaticArpRead(d, m)
+}
+
+func resourceNsxtPolicyStaticArpRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	segmentPath := d.Get("segment_path").(string)
+	gwID, segmentID, err := parseStaticArpSegmentPath(segmentPath)
+	if err != nil {
+		return err
+	}
+
+	client := segments.NewDefaultStaticArpClient(connector)
+	obj, err := client.Get(gwID, segmentID)
+	if err != nil {
+		return handleReadError(d, "Static ARP", segmentID, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("ip_address", obj.IpAddress)
+	d.Set("mac_address", obj.MacAddress)
+
+	return nil
+}
+
+func resourceNsxtPolicyStaticArpUpdate(d *schema.ResourceData, m interface{}) error {
+	segmentPath := d.Get("segment_path").(string)
+	gwID, segmentID, err := parseStaticArpSegmentPath(segmentPath)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating Static ARP for Segment %s", segmentPath)
+	err = patchNsxtPolicyStaticArp(d, m, gwID, segmentID)
+	if err != nil {
+		return handleUpdateError("Static ARP", segmentID, err)
+	}
+
+	return resourceNsxtPolicyStaticArpRead(d, m)
+}
+
+func resourceNsxtPolicyStaticArpDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	segmentPath := d.Get("segment_path").(string)
+	gwID, segmentID, err := parseStaticArpSegmentPath(segmentPath)
+	if err != nil {
+		return err
+	}
+
+	client := segments.NewDefaultStaticArpClient(connector)
+	err = client.Delete(gwID, segmentID)
+	if err != nil {
+		return handleDeleteError("Static ARP", segmentID, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyStaticArpImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	segmentPath := d.Id()
+
+	_, segmentID, err := parseStaticArpSegmentPath(segmentPath)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("segment_path", segmentPath)
+	d.SetId(segmentID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,157 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/segments"
+)
+
+func TestAccResourceNsxtPolicyStaticArp_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_static_arp.test"
+	displayName := getAccTestResourceName()
+	updatedDisplayName := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyStaticArpCheckDestroy(state, updatedDisplayName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyStaticArpTemplate(displayName, "12.12.2.10", "00:50:56:01:02:03", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyStaticArpExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", displayName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address", "12.12.2.10"),
+					resource.TestCheckResourceAttr(testResourceName, "mac_address", "00:50:56:01:02:03"),
+					resource.TestCheckResourceAttrSet(testResourceName, "segment_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyStaticArpTemplate(updatedDisplayName, "12.12.2.20", "00:50:56:0a:0b:0c", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyStaticArpExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedDisplayName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address", "12.12.2.20"),
+					resource.TestCheckResourceAttr(testResourceName, "mac_address", "00:50:56:0a:0b:0c"),
+					resource.TestCheckResourceAttrSet(testResourceName, "segment_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyStaticArp_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_static_arp.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyStaticArpCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyStaticArpTemplate(name, "12.12.2.10", "00:50:56:01:02:03", false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNSXPolicyStaticArpImporterGetID(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyStaticArpExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Static ARP resource %s not found in resources", resourceName)
+		}
+
+		gwID, segmentID, err := parseStaticArpSegmentPath(rs.Primary.Attributes["segment_path"])
+		if err != nil {
+			return err
+		}
+
+		client := segments.NewDefaultStaticArpClient(connector)
+		_, err = client.Get(gwID, segmentID)
+		return err
+	}
+}
+
+func testAccNsxtPolicyStaticArpCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_static_arp" {
+			continue
+		}
+
+		gwID, segmentID, err := parseStaticArpSegmentPath(rs.Primary.Attributes["segment_path"])
+		if err != nil {
+			return err
+		}
+
+		client := segments.NewDefaultStaticArpClient(connector)
+		_, err = client.Get(gwID, segmentID)
+		if err == nil {
+			return fmt.Errorf("Policy Static ARP %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXPolicyStaticArpImporterGetID(resourceName string) func(*terraform.State) (string, error) {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("NSX Policy Static ARP resource %s not found in resources", resourceName)
+		}
+		segmentPath := rs.Primary.Attributes["segment_path"]
+		if segmentPath == "" {
+			return "", fmt.Errorf("NSX Policy Static ARP segment_path not set in resources")
+		}
+		return segmentPath, nil
+	}
+}
+
+func testAccNsxtPolicyStaticArpTemplate(displayName string, ipAddress string, macAddress string, withTags bool) string {
+	tags := ""
+	if withTags {
+		tags = `
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }`
+	}
+	return testAccNsxtPolicyFixedSegmentImportTemplate(getOverlayTransportZoneName(), getAccTestResourceName()) + fmt.Sprintf(`
+
+resource "nsxt_policy_static_arp" "test" {
+  segment_path = nsxt_policy_fixed_segment.test.path
+  display_name = "%s"
+  description  = "terraform created"
+  ip_address   = "%s"
+  mac_address  = "%s"
+  %s
+}`, displayName, ipAddress, macAddress, tags)
+}
@@ -68,6 +68,15 @@ func resourceNsxtPolicyStaticRoute() *schema.Resource {
 							Description:  "Interface path associated with current route",
 							ValidateFunc: validatePolicyPath(),
 						},
+						"scope": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "List of gateway interface paths the next hop is reachable through",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validatePolicyPath(),
+							},
+						},
 					},
 				},
 			},
@@ -102,6 +111,73 @@ func getNsxtPolicyStaticRouteByID(connector *client.RestConnector, gwID string,
 	return routeClient.Get(gwID, routeID)
 }
 
+func getPolicyStaticRouteNextHopsFromSchema(d *schema.ResourceData) ([]model.RouterNexthop, error) {
+	var nextHopsStructs []model.RouterNexthop
+	nextHops := d.Get("next_hop").([]interface{})
+	for _, nextHop := range nextHops {
+		nextHopMap := nextHop.(map[string]interface{})
+		distance := int64(nextHopMap["admin_distance"].(int))
+		ip := nextHopMap["ip_address"].(string)
+		iface := nextHopMap["interface"].(string)
+		scopeList := interface2StringList(nextHopMap["scope"].([]interface{}))
+		if iface != "" {
+			if len(scopeList) > 0 {
+				return nil, fmt.Errorf("Only one of interface and scope can be specified for next hop")
+			}
+			scopeList = append(scopeList, iface)
+		}
+		hopStruct := model.RouterNexthop{
+			AdminDistance: &distance,
+			Scope:         scopeList,
+		}
+
+		if len(ip) > 0 {
+			hopStruct.IpAddress = &ip
+		}
+		nextHopsStructs = append(nextHopsStructs, hopStruct)
+	}
+
+	return nextHopsStructs, nil
+}
+
+func setPolicyStaticRouteNextHopsInSchema(d *schema.ResourceData, nextHops []model.RouterNexthop) {
+	// Single interface is restored into interface attribute, unless scope
+	// was used for this next hop in configuration
+	oldNextHops := d.Get("next_hop").([]interface{})
+	var nextHopMaps []map[string]interface{}
+	for i, nextHop := range nextHops {
+		nextHopMap := make(map[string]interface{})
+
+		useScope := len(nextHop.Scope) > 1
+		if i < len(oldNextHops) && oldNextHops[i] != nil {
+			oldScope := oldNextHops[i].(map[string]interface{})["scope"].([]interface{})
+			if len(oldScope) > 0 {
+				useScope = true
+			}
+		}
+
+		iface := ""
+		var scope []string
+		if useScope {
+			scope = nextHop.Scope
+		} else if len(nextHop.Scope) > 0 {
+			iface = nextHop.Scope[0]
+		}
+		nextHopMap["interface"] = iface
+		nextHopMap["scope"] = scope
+		if nextHop.IpAddress != nil {
+			nextHopMap["ip_address"] = *nextHop.IpAddress
+		}
+		if nextHop.AdminDistance != nil {
+			nextHopMap["admin_distance"] = nextHop.AdminDistance
+		}
+
+		nextHopMaps = append(nextHopMaps, nextHopMap)
+	}
+
+	d.Set("next_hop", nextHopMaps)
+}
+
 func resourceNsxtPolicyStaticRouteCreate(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
 
@@ -128,26 +204,9 @@ func resourceNsxtPolicyStaticRouteCreate(d *schema.ResourceData, m interface{})
 	tags := getPolicyTagsFromSchema(d)
 	network := d.Get("network").(string)
 
-	var nextHopsStructs []model.RouterNexthop
-	nextHops := d.Get("next_hop").([]interface{})
-	for _, nextHop := range nextHops {
-		nextHopMap := nextHop.(map[string]interface{})
-		distance := int64(nextHopMap["admin_distance"].(int))
-		ip := nextHopMap["ip_address"].(string)
-		scope := nextHopMap["interface"].(string)
-		var scopeList []string
-		if scope != "" {
-			scopeList = append(scopeList, scope)
-		}
-		hopStruct := model.RouterNexthop{
-			AdminDistance: &distance,
-			Scope:         scopeList,
-		}
-
-		if len(ip) > 0 {
-			hopStruct.IpAddress = &ip
-		}
-		nextHopsStructs = append(nextHopsStructs, hopStruct)
+	nextHopsStructs, err := getPolicyStaticRouteNextHopsFromSchema(d)
+	if err != nil {
+		return err
 	}
 
 	routeStruct := model.StaticRoutes{
@@ -163,7 +222,7 @@ func resourceNsxtPolicyStaticRouteCreate(d *schema.ResourceData, m interface{})
 	}
 
 	log.Printf("[INFO] Creating Static Route with ID %s", id)
-	err := patchNsxtPolicyStaticRoute(connector, gwID, routeStruct, isT0)
+	err = patchNsxtPolicyStaticRoute(connector, gwID, routeStruct, isT0)
 	if err != nil {
 		return handleCreateError("Static Route", id, err)
 	}
@@ -201,27 +260,7 @@ func resourceNsxtPolicyStaticRouteRead(d *schema.ResourceData, m interface{}) er
 	d.Set("revision", obj.Revision)
 	d.Set("network", obj.Network)
 
-	var nextHopMaps []map[string]interface{}
-	for _, nextHop := range obj.NextHops {
-		nextHopMap := make(map[string]interface{})
-
-		iface := ""
-		scope := nextHop.Scope
-		if len(scope) > 0 {
-			iface = scope[0]
-		}
-		nextHopMap["interface"] = iface
-		if nextHop.IpAddress != nil {
-			nextHopMap["ip_address"] = *nextHop.IpAddress
-		}
-		if nextHop.AdminDistance != nil {
-			nextHopMap["admin_distance"] = nextHop.AdminDistance
-		}
-
-		nextHopMaps = append(nextHopMaps, nextHopMap)
-	}
-
-	d.Set("next_hop", nextHopMaps)
+	setPolicyStaticRouteNextHopsInSchema(d, obj.NextHops)
 
 	d.SetId(id)
 
@@ -247,25 +286,9 @@ func resourceNsxtPolicyStaticRouteUpdate(d *schema.ResourceData, m interface{})
 	tags := getPolicyTagsFromSchema(d)
 	network := d.Get("network").(string)
 
-	var nextHopsStructs []model.RouterNexthop
-	nextHops := d.Get("next_hop").([]interface{})
-	for _, nextHop := range nextHops {
-		nextHopMap := nextHop.(map[string]interface{})
-		distance := int64(nextHopMap["admin_distance"].(int))
-		ip := nextHopMap["ip_address"].(string)
-		scope := nextHopMap["interface"].(string)
-		var scopeList []string
-		if scope != "" {
-			scopeList = append(scopeList, scope)
-		}
-		hopStruct := model.RouterNexthop{
-			AdminDistance: &distance,
-			Scope:         scopeList,
-		}
-		if len(ip) > 0 {
-			hopStruct.IpAddress = &ip
-		}
-		nextHopsStructs = append(nextHopsStructs, hopStruct)
+	nextHopsStructs, err := getPolicyStaticRouteNextHopsFromSchema(d)
+	if err != nil {
+		return err
 	}
 
 	routeStruct := model.StaticRoutes{
@@ -281,7 +304,7 @@ func resourceNsxtPolicyStaticRouteUpdate(d *schema.ResourceData, m interface{})
 	}
 
 	log.Printf("[INFO] Updating Static Route with ID %s", id)
-	err := patchNsxtPolicyStaticRoute(connector, gwID, routeStruct, isT0)
+	err = patchNsxtPolicyStaticRoute(connector, gwID, routeStruct, isT0)
 	if err != nil {
 		return handleUpdateError("Static Route", id, err)
 	}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -362,3 +363,44 @@ resource "nsxt_policy_static_route" "test" {
 }
 `, testAccResourcePolicyStaticRouteGatewayName, name, network)
 }
+
+func TestPolicyStaticRouteNextHopScopeRoundTrip(t *testing.T) {
+	uplink1 := "/infra/tier-0s/t0/locale-services/default/interfaces/uplink1"
+	uplink2 := "/infra/tier-0s/t0/locale-services/default/interfaces/uplink2"
+	d := schema.TestResourceDataRaw(t, resourceNsxtPolicyStaticRoute().Schema, map[string]interface{}{
+		"next_hop": []interface{}{
+			map[string]interface{}{"ip_address": "9.10.10.1", "interface": uplink1},
+			map[string]interface{}{"ip_address": "10.10.10.1", "scope": []interface{}{uplink1, uplink2}},
+			map[string]interface{}{"ip_address": "11.10.10.1", "scope": []interface{}{uplink2}},
+		},
+	})
+
+	nextHops, err := getPolicyStaticRouteNextHopsFromSchema(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nextHops) != 3 || len(nextHops[0].Scope) != 1 || len(nextHops[1].Scope) != 2 || len(nextHops[2].Scope) != 1 {
+		t.Fatalf("Unexpected next hops %v", nextHops)
+	}
+
+	setPolicyStaticRouteNextHopsInSchema(d, nextHops)
+	if d.Get("next_hop.0.interface").(string) != uplink1 || d.Get("next_hop.0.scope.#").(int) != 0 {
+		t.Errorf("Expected single interface to be restored in interface attribute")
+	}
+	if d.Get("next_hop.1.interface").(string) != "" || d.Get("next_hop.1.scope.1").(string) != uplink2 {
+		t.Errorf("Expected multiple interfaces to be restored in scope attribute")
+	}
+	if d.Get("next_hop.2.interface").(string) != "" || d.Get("next_hop.2.scope.0").(string) != uplink2 {
+		t.Errorf("Expected configured scope to be restored in scope attribute")
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceNsxtPolicyStaticRoute().Schema, map[string]interface{}{
+		"next_hop": []interface{}{
+			map[string]interface{}{"interface": uplink1, "scope": []interface{}{uplink2}},
+		},
+	})
+	_, err = getPolicyStaticRouteNextHopsFromSchema(d)
+	if err == nil {
+		t.Errorf("Expected error when both interface and scope are specified")
+	}
+}
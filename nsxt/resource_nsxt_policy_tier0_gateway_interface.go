@@ -409,9 +409,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m inter
 	if obj.Ipv6ProfilePaths != nil {
 		d.Set("ipv6_ndra_profile_path", obj.Ipv6ProfilePaths[0]) // only one supported for now
 	}
-	if obj.Mtu != nil {
-		d.Set("mtu", *obj.Mtu)
-	}
+	d.Set("mtu", obj.Mtu)
 
 	if obj.Multicast != nil {
 		d.Set("enable_pim", *obj.Multicast.Enabled)
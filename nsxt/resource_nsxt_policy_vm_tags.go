@@ -196,6 +196,35 @@ func updateNsxtPolicyVMTags(connector *client.RestConnector, externalID string,
 	return client.Updatetags(getPolicyEnforcementPoint(m), tagUpdate)
 }
 
+// Tags with scopes configured in this resource are considered managed by the
+// provider. Tags with other scopes might be added by external systems, and
+// should not be modified or removed.
+func getPolicyTagScopes(tags []model.Tag) map[string]bool {
+	scopes := make(map[string]bool)
+	for _, tag := range tags {
+		scope := ""
+		if tag.Scope != nil {
+			scope = *tag.Scope
+		}
+		scopes[scope] = true
+	}
+	return scopes
+}
+
+func filterPolicyTagsByScope(tags []model.Tag, scopes map[string]bool, managed bool) []model.Tag {
+	result := make([]model.Tag, 0)
+	for _, tag := range tags {
+		scope := ""
+		if tag.Scope != nil {
+			scope = *tag.Scope
+		}
+		if scopes[scope] == managed {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
 func listPolicyVifAttachmentsForVM(m interface{}, externalID string) ([]string, error) {
 	var vifAttachmentIds []string
 	vifs, err := listAllPolicyVifs(m)
@@ -212,7 +241,25 @@ func listPolicyVifAttachmentsForVM(m interface{}, externalID string) ([]string,
 	return vifAttachmentIds, nil
 }
 
-func updateNsxtPolicyVMPortTags(connector *client.RestConnector, externalID string, portTags []interface{}, m interface{}, isDelete bool) error {
+// Scopes of port tags configured in this resource, by segment path
+func getPolicyVMPortTagScopes(portTags []interface{}) map[string]map[string]bool {
+	scopes := make(map[string]map[string]bool)
+	for _, portTag := range portTags {
+		data := portTag.(map[string]interface{})
+		segmentPath := data["segment_path"].(string)
+		if scopes[segmentPath] == nil {
+			scopes[segmentPath] = make(map[string]bool)
+		}
+		for scope := range getPolicyTagScopes(getPolicyTagsFromSet(data["tag"].(*schema.Set))) {
+			scopes[segmentPath][scope] = true
+		}
+	}
+	return scopes
+}
+
+// Old port tags are used to remove tags that are no longer configured, while
+// preserving tags with scopes that were never configured in this resource
+func updateNsxtPolicyVMPortTags(connector *client.RestConnector, externalID string, portTags []interface{}, oldPortTags []interface{}, m interface{}, isDelete bool) error {
 
 	client := segments.NewDefaultPortsClient(connector)
 
@@ -221,12 +268,14 @@ func updateNsxtPolicyVMPortTags(connector *client.RestConnector, externalID stri
 		return err
 	}
 
+	oldScopes := getPolicyVMPortTagScopes(oldPortTags)
 	for _, portTag := range portTags {
 		data := portTag.(map[string]interface{})
 		segmentPath := data["segment_path"].(string)
-		var tags []model.Tag
-		if !isDelete {
-			tags = getPolicyTagsFromSet(data["tag"].(*schema.Set))
+		configuredTags := getPolicyTagsFromSet(data["tag"].(*schema.Set))
+		scopes := getPolicyTagScopes(configuredTags)
+		for scope := range oldScopes[segmentPath] {
+			scopes[scope] = true
 		}
 
 		ports, portsErr := listAllPolicySegmentPorts(connector, segmentPath)
@@ -240,8 +289,12 @@ func updateNsxtPolicyVMPortTags(connector *client.RestConnector, externalID stri
 
 			for _, attachment := range vifAttachmentIds {
 				if attachment == *port.Attachment.Id {
-					port.Tags = tags
-					log.Printf("[DEBUG] Updating port %s with %d tags", *port.Path, len(tags))
+					// Modify only tags managed by this resource
+					port.Tags = filterPolicyTagsByScope(port.Tags, scopes, false)
+					if !isDelete {
+						port.Tags = append(port.Tags, configuredTags...)
+					}
+					log.Printf("[DEBUG] Updating port %s with %d tags", *port.Path, len(port.Tags))
 					segmentID := getPolicyIDFromPath(segmentPath)
 					_, err = client.Update(segmentID, *port.Id, port)
 					if err != nil {
@@ -269,6 +322,7 @@ func setPolicyVMPortTagsInSchema(d *schema.ResourceData, m interface{}, external
 	for _, portTag := range portTags {
 		data := portTag.(map[string]interface{})
 		segmentPath := data["segment_path"].(string)
+		scopes := getPolicyTagScopes(getPolicyTagsFromSet(data["tag"].(*schema.Set)))
 
 		ports, portsErr := listAllPolicySegmentPorts(connector, segmentPath)
 		if portsErr != nil {
@@ -283,7 +337,7 @@ func setPolicyVMPortTagsInSchema(d *schema.ResourceData, m interface{}, external
 				if attachment == *port.Attachment.Id {
 					tags := make(map[string]interface{})
 					tags["segment_path"] = segmentPath
-					tags["tag"] = initPolicyTagsSet(port.Tags)
+					tags["tag"] = initPolicyTagsSet(filterPolicyTagsByScope(port.Tags, scopes, true))
 					actualPortTags = append(actualPortTags, tags)
 				}
 			}
@@ -308,11 +362,13 @@ func resourceNsxtPolicyVMTagsRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during Virtual Machine retrieval: %v", err)
 	}
 
-	setPolicyTagsInSchema(d, vm.Tags)
-
 	if d.Get("instance_id") == "" {
-		// for import
+		// for import, all tags are considered managed
+		setPolicyTagsInSchema(d, vm.Tags)
 		d.Set("instance_id", vm.ExternalId)
+	} else {
+		scopes := getPolicyTagScopes(getPolicyTagsFromSchema(d))
+		setPolicyTagsInSchema(d, filterPolicyTagsByScope(vm.Tags, scopes, true))
 	}
 
 	return setPolicyVMPortTagsInSchema(d, m, *vm.ExternalId)
@@ -327,18 +383,23 @@ func resourceNsxtPolicyVMTagsCreate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error finding Virtual Machine: %v", err)
 	}
 
-	tags := getPolicyTagsFromSchema(d)
-	if tags == nil {
-		tags = make([]model.Tag, 0)
+	// Preserve tags with scopes that were never configured in this resource
+	oldTags, newTags := d.GetChange("tag")
+	scopes := getPolicyTagScopes(getPolicyTagsFromSet(oldTags.(*schema.Set)))
+	for scope := range getPolicyTagScopes(getPolicyTagsFromSet(newTags.(*schema.Set))) {
+		scopes[scope] = true
 	}
+	tags := filterPolicyTagsByScope(vm.Tags, scopes, false)
+	tags = append(tags, getPolicyTagsFromSchema(d)...)
 
 	err = updateNsxtPolicyVMTags(connector, *vm.ExternalId, tags, m)
 	if err != nil {
 		return handleCreateError("Virtual Machine Tag", *vm.ExternalId, err)
 	}
 
-	portTags := d.Get("port").([]interface{})
-	err = updateNsxtPolicyVMPortTags(connector, *vm.ExternalId, portTags, m, false)
+	oldPortTags, newPortTags := d.GetChange("port")
+	portTags := newPortTags.([]interface{})
+	err = updateNsxtPolicyVMPortTags(connector, *vm.ExternalId, portTags, oldPortTags.([]interface{}), m, false)
 	if err != nil {
 		return handleCreateError("Segment Port Tag", *vm.ExternalId, err)
 	}
@@ -362,7 +423,9 @@ func resourceNsxtPolicyVMTagsDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error finding Virtual Machine: %v", err)
 	}
 
-	tags := make([]model.Tag, 0)
+	// Remove only tags managed by this resource
+	scopes := getPolicyTagScopes(getPolicyTagsFromSchema(d))
+	tags := filterPolicyTagsByScope(vm.Tags, scopes, false)
 	err = updateNsxtPolicyVMTags(connector, *vm.ExternalId, tags, m)
 
 	if err != nil {
@@ -370,7 +433,7 @@ func resourceNsxtPolicyVMTagsDelete(d *schema.ResourceData, m interface{}) error
 	}
 
 	portTags := d.Get("port").([]interface{})
-	err = updateNsxtPolicyVMPortTags(connector, *vm.ExternalId, portTags, m, true)
+	err = updateNsxtPolicyVMPortTags(connector, *vm.ExternalId, portTags, nil, m, true)
 	if err != nil {
 		return handleCreateError("Segment Port Tag", *vm.ExternalId, err)
 	}
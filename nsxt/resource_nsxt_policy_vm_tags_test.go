@@ -4,11 +4,17 @@
 package nsxt
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
 func TestAccResourceNsxtPolicyVMTags_basic(t *testing.T) {
@@ -42,6 +48,40 @@ func TestAccResourceNsxtPolicyVMTags_basic(t *testing.T) {
 	})
 }
 
+var testAccPolicyVMTagsExternalScope = "external"
+
+func TestAccResourceNsxtPolicyVMTags_externalTags(t *testing.T) {
+	vmID := getTestVMID()
+	testResourceName := "nsxt_policy_vm_tags.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccEnvDefined(t, "NSXT_TEST_VM_ID") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXPolicyVMTagsCheckExternalTagsDestroy(vmID)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXPolicyVMTagsCreateTemplate(vmID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXPolicyVMTagsCheckExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccNSXPolicyVMTagsAddExternalTag(t, vmID)
+				},
+				Config: testAccNSXPolicyVMTagsUpdateTemplate(vmID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXPolicyVMTagsCheckExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyVMTags_withPorts(t *testing.T) {
 	vmID := getTestVMID()
 	testResourceName := "nsxt_policy_vm_tags.test"
@@ -152,6 +192,36 @@ func testAccNSXPolicyVMTagsCheckDestroy(state *terraform.State) error {
 	return nil
 }
 
+func testAccNSXPolicyVMTagsAddExternalTag(t *testing.T, vmID string) {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	vm, err := findNsxtPolicyVMByID(connector, vmID, testAccProvider.Meta())
+	if err != nil {
+		t.Fatalf("Failed to find VM %s", vmID)
+	}
+
+	tag := "tag1"
+	tags := append(vm.Tags, model.Tag{Scope: &testAccPolicyVMTagsExternalScope, Tag: &tag})
+	err = updateNsxtPolicyVMTags(connector, *vm.ExternalId, tags, testAccProvider.Meta())
+	if err != nil {
+		t.Fatalf("Failed to add external tag to VM %s: %v", vmID, err)
+	}
+}
+
+func testAccNSXPolicyVMTagsCheckExternalTagsDestroy(vmID string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	vm, err := findNsxtPolicyVMByID(connector, vmID, testAccProvider.Meta())
+	if err != nil {
+		return fmt.Errorf("Failed to find VM %s", vmID)
+	}
+
+	if len(vm.Tags) != 1 || *vm.Tags[0].Scope != testAccPolicyVMTagsExternalScope {
+		return fmt.Errorf("VM %s is expected to keep only external tag, got %v", vmID, vm.Tags)
+	}
+
+	// Cleanup external tag
+	return updateNsxtPolicyVMTags(connector, *vm.ExternalId, make([]model.Tag, 0), testAccProvider.Meta())
+}
+
 func testAccNSXPolicyVMTagsCreateTemplate(instanceID string) string {
 	return fmt.Sprintf(`
 resource "nsxt_policy_vm_tags" "test" {
@@ -219,3 +289,105 @@ resource "nsxt_policy_vm_tags" "test" {
   }
 }`, instanceID, getTestVMSegmentID())
 }
+
+func TestFilterPolicyTagsByScope(t *testing.T) {
+	scope1 := "scope1"
+	scope2 := "external"
+	tag := "tag"
+	tags := []model.Tag{{Scope: &scope1, Tag: &tag}, {Scope: &scope2, Tag: &tag}, {Tag: &tag}}
+	scopes := getPolicyTagScopes([]model.Tag{{Scope: &scope1, Tag: &tag}})
+
+	managed := filterPolicyTagsByScope(tags, scopes, true)
+	if len(managed) != 1 || *managed[0].Scope != scope1 {
+		t.Errorf("Expected only tags with managed scope, got %v", managed)
+	}
+
+	external := filterPolicyTagsByScope(tags, scopes, false)
+	if len(external) != 2 || *external[0].Scope != scope2 || external[1].Scope != nil {
+		t.Errorf("Expected only tags with external scopes, got %v", external)
+	}
+}
+
+// Policy server with single VM port, that records tags sent on port update
+func newTestPolicyVMPortServer(t *testing.T, updatedTags *[]model.Tag) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/policy/api/v1/infra/realized-state/enforcement-points/default/vifs":
+			fmt.Fprint(w, `{"result_count": 1, "results": [{"resource_type": "VirtualNetworkInterface", "external_id": "vif1", "host_id": "host1", "owner_vm_id": "vm1", "lport_attachment_id": "att1"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/policy/api/v1/infra/segments/seg1/ports":
+			fmt.Fprint(w, `{"result_count": 1, "results": [{"id": "port1", "path": "/infra/segments/seg1/ports/port1", "attachment": {"id": "att1"},
+			  "tags": [{"scope": "color", "tag": "green"}, {"scope": "shape", "tag": "square"}, {"scope": "external", "tag": "tag1"}]}]}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/policy/api/v1/infra/segments/seg1/ports/port1":
+			var port struct {
+				Tags []model.Tag `json:"tags"`
+			}
+			err := json.NewDecoder(r.Body).Decode(&port)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			*updatedTags = port.Tags
+			fmt.Fprint(w, `{"id": "port1"}`)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func getTestPolicyVMPortTags(t *testing.T, tags ...map[string]interface{}) []interface{} {
+	var tagList []interface{}
+	for _, tag := range tags {
+		tagList = append(tagList, tag)
+	}
+	d := schema.TestResourceDataRaw(t, resourceNsxtPolicyVMTags().Schema, map[string]interface{}{
+		"instance_id": "vm1",
+		"port": []interface{}{map[string]interface{}{
+			"segment_path": "/infra/segments/seg1",
+			"tag":          tagList,
+		}},
+	})
+	return d.Get("port").([]interface{})
+}
+
+func TestUpdateNsxtPolicyVMPortTags_delete(t *testing.T) {
+	var updatedTags []model.Tag
+	server := newTestPolicyVMPortServer(t, &updatedTags)
+	defer server.Close()
+	connector := client.NewRestConnector(server.URL, *server.Client())
+	m := nsxtClients{PolicyHTTPClient: server.Client(), Host: server.URL, PolicyEnforcementPoint: "default"}
+
+	portTags := getTestPolicyVMPortTags(t, map[string]interface{}{"scope": "color", "tag": "green"}, map[string]interface{}{"scope": "shape", "tag": "square"})
+	err := updateNsxtPolicyVMPortTags(connector, "vm1", portTags, nil, m, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updatedTags) != 1 || *updatedTags[0].Scope != "external" {
+		t.Errorf("Expected only external port tag to survive destroy, got %v", updatedTags)
+	}
+}
+
+func TestUpdateNsxtPolicyVMPortTags_update(t *testing.T) {
+	var updatedTags []model.Tag
+	server := newTestPolicyVMPortServer(t, &updatedTags)
+	defer server.Close()
+	connector := client.NewRestConnector(server.URL, *server.Client())
+	m := nsxtClients{PolicyHTTPClient: server.Client(), Host: server.URL, PolicyEnforcementPoint: "default"}
+
+	// Shape tag is removed from configuration, and color tag is modified
+	oldPortTags := getTestPolicyVMPortTags(t, map[string]interface{}{"scope": "color", "tag": "green"}, map[string]interface{}{"scope": "shape", "tag": "square"})
+	portTags := getTestPolicyVMPortTags(t, map[string]interface{}{"scope": "color", "tag": "red"})
+	err := updateNsxtPolicyVMPortTags(connector, "vm1", portTags, oldPortTags, m, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range updatedTags {
+		tags[*tag.Scope] = *tag.Tag
+	}
+	if len(tags) != 2 || tags["external"] != "tag1" || tags["color"] != "red" {
+		t.Errorf("Expected external port tag to survive update along with configured tag, got %v", updatedTags)
+	}
+}
@@ -215,7 +215,7 @@ func resourceNsxtQosSwitchingProfileCreate(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Error during QosSwitchingProfile create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during QosSwitchingProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(qosSwitchingProfile.Id)
@@ -73,7 +73,7 @@ func resourceNsxtSpoofGuardSwitchingProfileCreate(d *schema.ResourceData, m inte
 		return fmt.Errorf("Error during SpoofGuardSwitchingProfile create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during SpoofGuardSwitchingProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(sgSwitchingProfile.Id)
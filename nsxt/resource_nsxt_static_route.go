@@ -4,13 +4,14 @@
 package nsxt
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
 	"github.com/vmware/go-vmware-nsxt/common"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
@@ -28,29 +29,21 @@ func resourceNsxtStaticRoute() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"logical_router_id": {
 				Type:        schema.TypeString,
-				Description: "Logical router id",
+				Description: "Logical router id, or policy path of the gateway realized as this router",
 				Required:    true,
 			},
 			"network": {
 				Type:         schema.TypeString,
 				Description:  "CIDR",
 				Required:     true,
-				ValidateFunc: validation.IsCIDRNetwork(0, 32),
+				ValidateFunc: validateCidrNetwork(),
+				StateFunc:    normalizeCidrStateFunc,
 			},
-			"next_hop": getNextHopsSchema(),
-			"revision": getRevisionSchema(),
-			"description": {
-				Type:        schema.TypeString,
-				Description: "Description of this resource",
-				Optional:    true,
-			},
-			"display_name": {
-				Type:        schema.TypeString,
-				Description: "Defaults to ID if not set",
-				Optional:    true,
-				Computed:    true,
-			},
-			"tag": getTagsSchema(),
+			"next_hop":     getNextHopsSchema(),
+			"revision":     getRevisionSchema(),
+			"description":  getMPDescriptionSchema(),
+			"display_name": getMPDisplayNameSchema(),
+			"tag":          getTagsSchema(),
 		},
 	}
 }
@@ -81,6 +74,7 @@ func getNextHopsSchema() *schema.Schema {
 					Description:  "Next Hop IP",
 					Optional:     true,
 					ValidateFunc: validateSingleIP(),
+					StateFunc:    normalizeIPStateFunc,
 				},
 				"logical_router_port_id": {
 					Type:        schema.TypeString,
@@ -134,13 +128,47 @@ func setNextHopsInSchema(d *schema.ResourceData, nextHops []manager.StaticRouteN
 	return err
 }
 
+// Logical router can also be specified with policy path of the gateway, in
+// which case ID of the manager router realized from the gateway is used.
+// Policy connector is only needed to resolve the path.
+func getStaticRouteLogicalRouterID(d *schema.ResourceData, m interface{}) (string, error) {
+	logicalRouterID := d.Get("logical_router_id").(string)
+	if !isPolicyPath(logicalRouterID) {
+		return logicalRouterID, nil
+	}
+
+	return getMPIDFromPathOrMPID(getPolicyConnector(m), logicalRouterID, realizedLogicalRouterEntityType)
+}
+
+func listLogicalRouterStaticRoutes(nsxClient *api.APIClient, routerID string) ([]manager.StaticRoute, error) {
+	var routes []manager.StaticRoute
+	lister := func(info *paginationInfo) error {
+		objList, _, err := nsxClient.LogicalRoutingAndServicesApi.ListStaticRoutes(nsxClient.Context, routerID, info.LocalVarOptionals)
+		if err != nil {
+			return fmt.Errorf("Error while reading static routes on router %s: %v", routerID, err)
+		}
+
+		info.PageCount = int64(len(objList.Results))
+		info.TotalCount = objList.ResultCount
+		info.Cursor = objList.Cursor
+		routes = append(routes, objList.Results...)
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	return routes, err
+}
+
 func resourceNsxtStaticRouteCreate(d *schema.ResourceData, m interface{}) error {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
 		return resourceNotSupportedError()
 	}
 
-	logicalRouterID := d.Get("logical_router_id").(string)
+	logicalRouterID, err := getStaticRouteLogicalRouterID(d, m)
+	if err != nil {
+		return err
+	}
 	if logicalRouterID == "" {
 		return fmt.Errorf("Error obtaining logical router id during static route creation")
 	}
@@ -161,14 +189,29 @@ func resourceNsxtStaticRouteCreate(d *schema.ResourceData, m interface{}) error
 
 	staticRoute, resp, err := nsxClient.LogicalRoutingAndServicesApi.AddStaticRoute(nsxClient.Context, logicalRouterID, staticRoute)
 
+	err = wrapMPAPIError(resp, err)
 	if err != nil {
 		return fmt.Errorf("Error during StaticRoute create on router %s: %v", logicalRouterID, err)
 	}
 
-	if resp.StatusCode != http.StatusOK {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during StaticRoute create on router %s: %v", logicalRouterID, resp.StatusCode)
 	}
 	d.SetId(staticRoute.Id)
+	// Store revision from create response, so that state has a valid
+	// revision even if the following read fails
+	d.Set("revision", staticRoute.Revision)
+
+	if defaultName := getDefaultMPDisplayName(d, m, staticRoute.Id); defaultName != "" {
+		staticRoute.DisplayName = defaultName
+		var updatedRoute manager.StaticRoute
+		updatedRoute, resp, err = nsxClient.LogicalRoutingAndServicesApi.UpdateStaticRoute(nsxClient.Context, logicalRouterID, staticRoute.Id, staticRoute)
+		err = wrapMPAPIError(resp, err)
+		if err != nil {
+			return fmt.Errorf("Error during StaticRoute %s display name update: %v", staticRoute.Id, err)
+		}
+		d.Set("revision", updatedRoute.Revision)
+	}
 
 	return resourceNsxtStaticRouteRead(d, m)
 }
@@ -184,14 +227,24 @@ func resourceNsxtStaticRouteRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error obtaining logical object id")
 	}
 
-	logicalRouterID := d.Get("logical_router_id").(string)
+	logicalRouterID, err := getStaticRouteLogicalRouterID(d, m)
+	if err != nil {
+		return err
+	}
 	if logicalRouterID == "" {
 		return fmt.Errorf("Error obtaining logical router id during static route read")
 	}
 
-	staticRoute, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadStaticRoute(nsxClient.Context, logicalRouterID, id)
-	if resp != nil && resp.StatusCode == http.StatusNotFound {
-		log.Printf("[DEBUG] StaticRoute %s not found", id)
+	var staticRoute manager.StaticRoute
+	err = retryOnNotFound(d, m, func() error {
+		var resp *http.Response
+		var err error
+		staticRoute, resp, err = nsxClient.LogicalRoutingAndServicesApi.ReadStaticRoute(nsxClient.Context, logicalRouterID, id)
+		return wrapMPAPIError(resp, err)
+	})
+	if errors.Is(err, ErrNotFound) {
+		code, _ := getMPAPIErrorCode(err)
+		log.Printf("[DEBUG] StaticRoute %s not found (error code %d)", id, code)
 		d.SetId("")
 		return nil
 	}
@@ -201,9 +254,12 @@ func resourceNsxtStaticRouteRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("revision", staticRoute.Revision)
 	d.Set("description", staticRoute.Description)
-	d.Set("display_name", staticRoute.DisplayName)
+	setMPDisplayNameInSchema(d, staticRoute.DisplayName)
 	setTagsInSchema(d, staticRoute.Tags)
-	d.Set("logical_router_id", staticRoute.LogicalRouterId)
+	// Policy path of the gateway is kept, since it resolves to this router
+	if !isPolicyPath(d.Get("logical_router_id").(string)) {
+		d.Set("logical_router_id", staticRoute.LogicalRouterId)
+	}
 	d.Set("network", staticRoute.Network)
 	err = setNextHopsInSchema(d, staticRoute.NextHops)
 	if err != nil {
@@ -224,7 +280,10 @@ func resourceNsxtStaticRouteUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error obtaining logical object id")
 	}
 
-	logicalRouterID := d.Get("logical_router_id").(string)
+	logicalRouterID, err := getStaticRouteLogicalRouterID(d, m)
+	if err != nil {
+		return err
+	}
 	if logicalRouterID == "" {
 		return fmt.Errorf("Error obtaining logical router id during static route update")
 	}
@@ -247,7 +306,8 @@ func resourceNsxtStaticRouteUpdate(d *schema.ResourceData, m interface{}) error
 
 	_, resp, err := nsxClient.LogicalRoutingAndServicesApi.UpdateStaticRoute(nsxClient.Context, logicalRouterID, id, staticRoute)
 
-	if err != nil || resp.StatusCode == http.StatusNotFound {
+	err = wrapMPAPIError(resp, err)
+	if err != nil {
 		return fmt.Errorf("Error during StaticRoute update: %v", err)
 	}
 
@@ -265,20 +325,27 @@ func resourceNsxtStaticRouteDelete(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error obtaining logical object id")
 	}
 
-	logicalRouterID := d.Get("logical_router_id").(string)
+	logicalRouterID, err := getStaticRouteLogicalRouterID(d, m)
+	if err != nil {
+		return err
+	}
 	if logicalRouterID == "" {
 		return fmt.Errorf("Error obtaining logical router id during static route deletion")
 	}
 
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteStaticRoute(nsxClient.Context, logicalRouterID, id)
+	err = wrapMPAPIError(resp, err)
+	if errors.Is(err, ErrNotFound) {
+		// Route is already deleted, either directly or with its router
+		code, _ := getMPAPIErrorCode(err)
+		log.Printf("[DEBUG] StaticRoute %s for router %s not found (error code %d)", id, logicalRouterID, code)
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("Error during StaticRoute delete: %v", err)
 	}
 
-	if resp.StatusCode == http.StatusNotFound {
-		log.Printf("[DEBUG] StaticRoute %s for router %s not found", id, logicalRouterID)
-		d.SetId("")
-	}
 	return nil
 }
 
@@ -4,12 +4,16 @@
 package nsxt
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 var testAccResourceStaticRouteName = "nsxt_static_route.test"
@@ -23,6 +27,45 @@ func TestAccResourceNsxtStaticRoute_onT0(t *testing.T) {
 	testAccResourceNsxtStaticRoute(t, "tier0")
 }
 
+func TestResourceNsxtStaticRouteCreate_revision(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/logical-routers/router1/routing/static-routes/route1" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/logical-routers/router1/routing/static-routes" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "route1", "logical_router_id": "router1", "network": "4.4.4.0/24", "_revision": 3}`)
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+
+	d := schema.TestResourceDataRaw(t, resourceNsxtStaticRoute().Schema, map[string]interface{}{
+		"logical_router_id": "router1",
+		"network":           "4.4.4.0/24",
+		"next_hop": []interface{}{
+			map[string]interface{}{"ip_address": "8.0.0.10"},
+		},
+	})
+
+	// Read following the create fails, revision should be stored regardless
+	err := resourceNsxtStaticRouteCreate(d, nsxtClients{NsxtClient: client})
+	if err == nil {
+		t.Errorf("Expected read error after create")
+	}
+	if d.Id() != "route1" {
+		t.Errorf("Expected ID route1, got %s", d.Id())
+	}
+	if revision := d.Get("revision").(int); revision != 3 {
+		t.Errorf("Expected revision 3 after create, got %d", revision)
+	}
+}
+
 func testAccResourceNsxtStaticRoute(t *testing.T, tier string) {
 	name := getAccTestResourceName()
 	updateName := getAccTestResourceName()
@@ -42,6 +85,7 @@ func testAccResourceNsxtStaticRoute(t *testing.T, tier string) {
 					testAccNSXStaticRouteCheckExists(name, testAccResourceStaticRouteName),
 					resource.TestCheckResourceAttr(testAccResourceStaticRouteName, "display_name", name),
 					resource.TestCheckResourceAttr(testAccResourceStaticRouteName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttrSet(testAccResourceStaticRouteName, "revision"),
 					resource.TestCheckResourceAttrSet(testAccResourceStaticRouteName, "logical_router_id"),
 					resource.TestCheckResourceAttr(testAccResourceStaticRouteName, "tag.#", "1"),
 					resource.TestCheckResourceAttr(testAccResourceStaticRouteName, "network", "4.4.4.0/24"),
@@ -268,3 +312,139 @@ resource "nsxt_static_route" "test" {
   }
 }`, tier, name)
 }
+
+func TestResourceNsxtStaticRouteCreate_namePrefix(t *testing.T) {
+	var updated manager.StaticRoute
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/logical-routers/router1/routing/static-routes":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "route1", "logical_router_id": "router1", "network": "4.4.4.0/24", "_revision": 0}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/logical-routers/router1/routing/static-routes/route1":
+			err := json.NewDecoder(r.Body).Decode(&updated)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprintf(w, `{"id": "route1", "display_name": "%s", "_revision": 1}`, updated.DisplayName)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/logical-routers/router1/routing/static-routes/route1":
+			fmt.Fprintf(w, `{"id": "route1", "display_name": "%s", "logical_router_id": "router1", "network": "4.4.4.0/24",
+			  "next_hops": [{"ip_address": "8.0.0.10", "administrative_distance": 1}], "_revision": 1}`, updated.DisplayName)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+	m := nsxtClients{NsxtClient: client, CommonConfig: commonProviderConfig{NamePrefix: "tf-"}}
+
+	d := schema.TestResourceDataRaw(t, resourceNsxtStaticRoute().Schema, map[string]interface{}{
+		"logical_router_id": "router1",
+		"network":           "4.4.4.0/24",
+		"next_hop": []interface{}{
+			map[string]interface{}{"ip_address": "8.0.0.10"},
+		},
+	})
+
+	err := resourceNsxtStaticRouteCreate(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.DisplayName != "tf-route1" {
+		t.Errorf("Expected display name tf-route1 to be assigned, got %s", updated.DisplayName)
+	}
+	if displayName := d.Get("display_name").(string); displayName != "tf-route1" {
+		t.Errorf("Expected display name tf-route1 in state, got %s", displayName)
+	}
+	if revision := d.Get("revision").(int); revision != 1 {
+		t.Errorf("Expected revision 1 after display name update, got %d", revision)
+	}
+}
+
+func TestGetDefaultMPDisplayName(t *testing.T) {
+	m := nsxtClients{CommonConfig: commonProviderConfig{NamePrefix: "tf-"}}
+	d := schema.TestResourceDataRaw(t, resourceNsxtStaticRoute().Schema, map[string]interface{}{})
+	if name := getDefaultMPDisplayName(d, m, "id1"); name != "tf-id1" {
+		t.Errorf("Expected default display name tf-id1, got %s", name)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceNsxtStaticRoute().Schema, map[string]interface{}{"display_name": "route"})
+	if name := getDefaultMPDisplayName(d, m, "id1"); name != "" {
+		t.Errorf("Expected no default display name when configured, got %s", name)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceNsxtStaticRoute().Schema, map[string]interface{}{})
+	if name := getDefaultMPDisplayName(d, nsxtClients{}, "id1"); name != "" {
+		t.Errorf("Expected no default display name without prefix, got %s", name)
+	}
+}
+
+func TestResourceNsxtStaticRouteDelete_alreadyDeleted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/session/create":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/logical-routers/router1/routing/static-routes/route1":
+			// Route was removed together with its router
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+	m := nsxtClients{NsxtClient: client}
+
+	d := schema.TestResourceDataRaw(t, resourceNsxtStaticRoute().Schema, map[string]interface{}{
+		"logical_router_id": "router1",
+		"network":           "4.4.4.0/24",
+	})
+	d.SetId("route1")
+	err := resourceNsxtStaticRouteDelete(d, m)
+	if err != nil {
+		t.Fatalf("expected already deleted route to be accepted, got: %v", err)
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected route to be removed from state")
+	}
+}
+
+func TestResourceNsxtStaticRouteRead_gatewayPath(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/session/create":
+			w.WriteHeader(http.StatusOK)
+		case r.URL.Path == "/policy/api/v1/infra/realized-state/realized-entities" && r.URL.Query().Get("intent_path") == testPolicyPath:
+			fmt.Fprintf(w, `{"result_count": 2, "results": %s}`, testPolicyRealizedEntities)
+		case r.Method == http.MethodGet && r.URL.Path == fmt.Sprintf("/api/v1/logical-routers/%s/routing/static-routes/route1", testMPID):
+			fmt.Fprintf(w, `{"id": "route1", "logical_router_id": "%s", "network": "4.4.4.0/24", "_revision": 2}`, testMPID)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+	m := nsxtClients{NsxtClient: client, PolicyHTTPClient: server.Client(), Host: server.URL}
+
+	d := schema.TestResourceDataRaw(t, resourceNsxtStaticRoute().Schema, map[string]interface{}{
+		"logical_router_id": testPolicyPath,
+		"network":           "4.4.4.0/24",
+	})
+	d.SetId("route1")
+	err := resourceNsxtStaticRouteRead(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "route1" || d.Get("revision").(int) != 2 {
+		t.Errorf("Expected route to be read from realized router, got id %s revision %d", d.Id(), d.Get("revision").(int))
+	}
+	if routerID := d.Get("logical_router_id").(string); routerID != testPolicyPath {
+		t.Errorf("Expected gateway path to be kept in state, got %s", routerID)
+	}
+}
@@ -205,7 +205,7 @@ func resourceNsxtSwitchSecuritySwitchingProfileCreate(d *schema.ResourceData, m
 		return fmt.Errorf("Error during SwitchSecurityProfile create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during SwitchSecurityProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(switchSecurityProfile.Id)
@@ -0,0 +1,388 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+// Note - the vendored MP SDK models host switch spec without its host switches,
+// hence host switch configuration is sent via host_switches property, which is
+// still supported by NSX for backward compatibility
+
+// Transport node deletion includes removal of NSX components from the node,
+// or undeployment of edge VM, and takes a while to complete
+const transportNodeDeleteTimeout = 20 * time.Minute
+
+func resourceNsxtTransportNode() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtTransportNodeCreate,
+		Read:   resourceNsxtTransportNodeRead,
+		Update: resourceNsxtTransportNodeUpdate,
+		Delete: resourceNsxtTransportNodeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(transportNodeDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision":     getRevisionSchema(),
+			"description":  getMPDescriptionSchema(),
+			"display_name": getMPDisplayNameSchema(),
+			"tag":          getTagsSchema(),
+			"node_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the fabric node (host or edge) to be prepared as transport node",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"host_switch":             getTransportNodeHostSwitchSchema(),
+			"transport_zone_endpoint": getTransportNodeTransportZoneEndpointSchema(),
+		},
+	}
+}
+
+func getTransportNodeHostSwitchSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Host switches to be created on the transport node",
+		Required:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host_switch_name": {
+					Type:        schema.TypeString,
+					Description: "Host switch name, must match host_switch_name of the transport zones this node joins",
+					Required:    true,
+				},
+				"host_switch_profile_id": {
+					Type:        schema.TypeSet,
+					Description: "Host switch profiles (of various types) to be associated with this host switch",
+					Optional:    true,
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key": {
+								Type:        schema.TypeString,
+								Description: "The resource type of this profile",
+								Required:    true,
+							},
+							"value": {
+								Type:        schema.TypeString,
+								Description: "The ID of this profile",
+								Required:    true,
+							},
+						},
+					},
+				},
+				"pnic": {
+					Type:        schema.TypeList,
+					Description: "Physical NICs connected to the host switch",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"device_name": {
+								Type:        schema.TypeString,
+								Description: "Device name or key",
+								Required:    true,
+							},
+							"uplink_name": {
+								Type:        schema.TypeString,
+								Description: "Uplink name for this physical NIC, as defined in the uplink profile",
+								Required:    true,
+							},
+						},
+					},
+				},
+				"static_ip_pool_id": {
+					Type:        schema.TypeString,
+					Description: "ID of IP pool for tunnel endpoint IP assignment. DHCP is used if not specified",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func getTransportNodeTransportZoneEndpointSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Transport zones this transport node belongs to",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"transport_zone_id": {
+					Type:        schema.TypeString,
+					Description: "Transport zone ID",
+					Required:    true,
+				},
+				"transport_zone_profile_ids": {
+					Type:        schema.TypeSet,
+					Description: "IDs of BFD health monitoring profiles for this transport zone endpoint",
+					Optional:    true,
+					Computed:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func getTransportNodeHostSwitchesFromSchema(d *schema.ResourceData) []manager.HostSwitch {
+	var hostSwitches []manager.HostSwitch
+	for _, hs := range d.Get("host_switch").([]interface{}) {
+		data := hs.(map[string]interface{})
+		var profiles []manager.HostSwitchProfileTypeIdEntry
+		for _, profile := range data["host_switch_profile_id"].(*schema.Set).List() {
+			profileData := profile.(map[string]interface{})
+			profiles = append(profiles, manager.HostSwitchProfileTypeIdEntry{
+				Key:   profileData["key"].(string),
+				Value: profileData["value"].(string),
+			})
+		}
+		var pnics []manager.Pnic
+		for _, pnic := range data["pnic"].([]interface{}) {
+			pnicData := pnic.(map[string]interface{})
+			pnics = append(pnics, manager.Pnic{
+				DeviceName: pnicData["device_name"].(string),
+				UplinkName: pnicData["uplink_name"].(string),
+			})
+		}
+		hostSwitches = append(hostSwitches, manager.HostSwitch{
+			HostSwitchName:       data["host_switch_name"].(string),
+			HostSwitchProfileIds: profiles,
+			Pnics:                pnics,
+			StaticIpPoolId:       data["static_ip_pool_id"].(string),
+		})
+	}
+
+	return hostSwitches
+}
+
+func setTransportNodeHostSwitchesInSchema(d *schema.ResourceData, hostSwitches []manager.HostSwitch) error {
+	var hostSwitchList []map[string]interface{}
+	for _, hs := range hostSwitches {
+		elem := make(map[string]interface{})
+		elem["host_switch_name"] = hs.HostSwitchName
+		elem["static_ip_pool_id"] = hs.StaticIpPoolId
+		var profiles []map[string]interface{}
+		for _, profile := range hs.HostSwitchProfileIds {
+			profiles = append(profiles, map[string]interface{}{
+				"key":   profile.Key,
+				"value": profile.Value,
+			})
+		}
+		elem["host_switch_profile_id"] = profiles
+		var pnics []map[string]interface{}
+		for _, pnic := range hs.Pnics {
+			pnics = append(pnics, map[string]interface{}{
+				"device_name": pnic.DeviceName,
+				"uplink_name": pnic.UplinkName,
+			})
+		}
+		elem["pnic"] = pnics
+		hostSwitchList = append(hostSwitchList, elem)
+	}
+
+	return d.Set("host_switch", hostSwitchList)
+}
+
+func getTransportNodeTransportZoneEndpointsFromSchema(d *schema.ResourceData) []manager.TransportZoneEndPoint {
+	var endpoints []manager.TransportZoneEndPoint
+	for _, endpoint := range d.Get("transport_zone_endpoint").([]interface{}) {
+		data := endpoint.(map[string]interface{})
+		var profiles []manager.TransportZoneProfileTypeIdEntry
+		for _, profileID := range data["transport_zone_profile_ids"].(*schema.Set).List() {
+			profiles = append(profiles, manager.TransportZoneProfileTypeIdEntry{
+				ProfileId:    profileID.(string),
+				ResourceType: "BfdHealthMonitoringProfile",
+			})
+		}
+		endpoints = append(endpoints, manager.TransportZoneEndPoint{
+			TransportZoneId:         data["transport_zone_id"].(string),
+			TransportZoneProfileIds: profiles,
+		})
+	}
+
+	return endpoints
+}
+
+func setTransportNodeTransportZoneEndpointsInSchema(d *schema.ResourceData, endpoints []manager.TransportZoneEndPoint) error {
+	var endpointList []map[string]interface{}
+	for _, endpoint := range endpoints {
+		elem := make(map[string]interface{})
+		elem["transport_zone_id"] = endpoint.TransportZoneId
+		var profileIDs []string
+		for _, profile := range endpoint.TransportZoneProfileIds {
+			profileIDs = append(profileIDs, profile.ProfileId)
+		}
+		elem["transport_zone_profile_ids"] = profileIDs
+		endpointList = append(endpointList, elem)
+	}
+
+	return d.Set("transport_zone_endpoint", endpointList)
+}
+
+func resourceNsxtTransportNodeCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	nodeID := d.Get("node_id").(string)
+	transportNode := manager.TransportNode{
+		Description:            description,
+		DisplayName:            displayName,
+		Tags:                   tags,
+		NodeId:                 nodeID,
+		HostSwitches:           getTransportNodeHostSwitchesFromSchema(d),
+		TransportZoneEndpoints: getTransportNodeTransportZoneEndpointsFromSchema(d),
+	}
+
+	transportNode, resp, err := nsxClient.NetworkTransportApi.CreateTransportNode(nsxClient.Context, transportNode)
+
+	if err != nil {
+		return fmt.Errorf("Error during TransportNode create: %v", err)
+	}
+
+	if !isSuccess(resp.StatusCode) {
+		return fmt.Errorf("Unexpected status returned during TransportNode create: %v", resp.StatusCode)
+	}
+	d.SetId(transportNode.Id)
+
+	if defaultName := getDefaultMPDisplayName(d, m, transportNode.Id); defaultName != "" {
+		transportNode.DisplayName = defaultName
+		_, resp, err = nsxClient.NetworkTransportApi.UpdateTransportNode(nsxClient.Context, transportNode.Id, transportNode, make(map[string]interface{}))
+		err = wrapMPAPIError(resp, err)
+		if err != nil {
+			return fmt.Errorf("Error during TransportNode %s display name update: %v", transportNode.Id, err)
+		}
+	}
+
+	return resourceNsxtTransportNodeRead(d, m)
+}
+
+func resourceNsxtTransportNodeRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	transportNode, resp, err := nsxClient.NetworkTransportApi.GetTransportNode(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] TransportNode %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during TransportNode read: %v", err)
+	}
+
+	d.Set("revision", transportNode.Revision)
+	d.Set("description", transportNode.Description)
+	setMPDisplayNameInSchema(d, transportNode.DisplayName)
+	setTagsInSchema(d, transportNode.Tags)
+	d.Set("node_id", transportNode.NodeId)
+	err = setTransportNodeHostSwitchesInSchema(d, transportNode.HostSwitches)
+	if err != nil {
+		return fmt.Errorf("Error during TransportNode host switches set in schema: %v", err)
+	}
+	err = setTransportNodeTransportZoneEndpointsInSchema(d, transportNode.TransportZoneEndpoints)
+	if err != nil {
+		return fmt.Errorf("Error during TransportNode transport zone endpoints set in schema: %v", err)
+	}
+
+	return nil
+}
+
+func resourceNsxtTransportNodeUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	revision := int64(d.Get("revision").(int))
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	nodeID := d.Get("node_id").(string)
+	transportNode := manager.TransportNode{
+		Revision:               revision,
+		Description:            description,
+		DisplayName:            displayName,
+		Tags:                   tags,
+		NodeId:                 nodeID,
+		HostSwitches:           getTransportNodeHostSwitchesFromSchema(d),
+		TransportZoneEndpoints: getTransportNodeTransportZoneEndpointsFromSchema(d),
+	}
+
+	localVarOptionals := make(map[string]interface{})
+	_, resp, err := nsxClient.NetworkTransportApi.UpdateTransportNode(nsxClient.Context, id, transportNode, localVarOptionals)
+
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during TransportNode update: %v", err)
+	}
+
+	return resourceNsxtTransportNodeRead(d, m)
+}
+
+func resourceNsxtTransportNodeDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.NetworkTransportApi.DeleteTransportNode(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during TransportNode delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] TransportNode %s not found", id)
+		d.SetId("")
+		return nil
+	}
+
+	return waitForTransportNodeDeletion(d, m, nsxClient, id)
+}
+
+func waitForTransportNodeDeletion(d *schema.ResourceData, m interface{}, nsxClient *api.APIClient, id string) error {
+	err := waitForDeletion(m, d.Timeout(schema.TimeoutDelete), func() error {
+		_, resp, err := nsxClient.NetworkTransportApi.GetTransportNode(nsxClient.Context, id)
+		return wrapMPAPIError(resp, err)
+	})
+	if err != nil {
+		return fmt.Errorf("Error while waiting for TransportNode %s deletion: %v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtTransportNode_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_transport_node.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccTestMP(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_FABRIC_NODE_ID")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXTransportNodeCheckDestroy(state, updateName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXTransportNodeCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXTransportNodeExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "node_id", getTestFabricNodeID()),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "host_switch.0.host_switch_name"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_zone_endpoint.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "transport_zone_endpoint.0.transport_zone_id"),
+				),
+			},
+			{
+				Config: testAccNSXTransportNodeUpdateTemplate(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXTransportNodeExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_zone_endpoint.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtTransportNode_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_transport_node.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccTestMP(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_FABRIC_NODE_ID")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXTransportNodeCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXTransportNodeCreateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXTransportNodeExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Transport Node resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Transport Node resource ID not set in resources ")
+		}
+
+		transportNode, responseCode, err := nsxClient.NetworkTransportApi.GetTransportNode(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Transport Node ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if Transport Node %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == transportNode.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("Transport Node %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXTransportNodeCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_transport_node" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		transportNode, responseCode, err := nsxClient.NetworkTransportApi.GetTransportNode(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving Transport Node ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == transportNode.DisplayName {
+			return fmt.Errorf("Transport Node %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXTransportNodePrerequisites() string {
+	return fmt.Sprintf(`
+data "nsxt_transport_zone" "test" {
+  display_name = "%s"
+}`, getOverlayTransportZoneName())
+}
+
+func testAccNSXTransportNodeCreateTemplate(name string) string {
+	return testAccNSXTransportNodePrerequisites() + fmt.Sprintf(`
+resource "nsxt_transport_node" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  node_id      = "%s"
+
+  host_switch {
+    host_switch_name = data.nsxt_transport_zone.test.host_switch_name
+  }
+
+  transport_zone_endpoint {
+    transport_zone_id = data.nsxt_transport_zone.test.id
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, getTestFabricNodeID())
+}
+
+func testAccNSXTransportNodeUpdateTemplate(updatedName string) string {
+	return testAccNSXTransportNodePrerequisites() + fmt.Sprintf(`
+resource "nsxt_transport_node" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test Update"
+  node_id      = "%s"
+
+  host_switch {
+    host_switch_name = data.nsxt_transport_zone.test.host_switch_name
+  }
+
+  transport_zone_endpoint {
+    transport_zone_id = data.nsxt_transport_zone.test.id
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  tag {
+    scope = "scope2"
+    tag   = "tag2"
+  }
+}`, updatedName, getTestFabricNodeID())
+}
@@ -0,0 +1,220 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+var transportZoneTransportTypeValues = []string{"OVERLAY", "VLAN"}
+
+// List cache key for transport zones, shared with the transport zone data source
+const transportZoneListCacheKey = "transport-zones"
+
+func resourceNsxtTransportZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtTransportZoneCreate,
+		Read:   resourceNsxtTransportZoneRead,
+		Update: resourceNsxtTransportZoneUpdate,
+		Delete: resourceNsxtTransportZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtResourceImporterByName("Transport Zone", resourceNsxtTransportZoneListForImport),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision":     getRevisionSchema(),
+			"description":  getMPDescriptionSchema(),
+			"display_name": getMPDisplayNameSchema(),
+			"tag":          getTagsSchema(),
+			"host_switch_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the host switch on all transport nodes in this transport zone that will be used to run NSX network traffic",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"nested_nsx": {
+				Type:        schema.TypeBool,
+				Description: "Whether this transport zone is used for nested NSX deployment",
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"transport_type": {
+				Type:         schema.TypeString,
+				Description:  "The transport type of this transport zone",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(transportZoneTransportTypeValues, false),
+			},
+		},
+	}
+}
+
+func resourceNsxtTransportZoneCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	hostSwitchName := d.Get("host_switch_name").(string)
+	nestedNsx := d.Get("nested_nsx").(bool)
+	transportType := d.Get("transport_type").(string)
+	transportZone := manager.TransportZone{
+		Description:    description,
+		DisplayName:    displayName,
+		Tags:           tags,
+		HostSwitchName: hostSwitchName,
+		NestedNsx:      nestedNsx,
+		TransportType:  transportType,
+	}
+
+	transportZone, resp, err := nsxClient.NetworkTransportApi.CreateTransportZone(nsxClient.Context, transportZone)
+
+	if err != nil {
+		return fmt.Errorf("Error during TransportZone create: %v", err)
+	}
+
+	if !isSuccess(resp.StatusCode) {
+		return fmt.Errorf("Unexpected status returned during TransportZone create: %v", resp.StatusCode)
+	}
+	d.SetId(transportZone.Id)
+	getListCache(m).invalidate(transportZoneListCacheKey)
+
+	if defaultName := getDefaultMPDisplayName(d, m, transportZone.Id); defaultName != "" {
+		transportZone.DisplayName = defaultName
+		_, resp, err = nsxClient.NetworkTransportApi.UpdateTransportZone(nsxClient.Context, transportZone.Id, transportZone)
+		err = wrapMPAPIError(resp, err)
+		if err != nil {
+			return fmt.Errorf("Error during TransportZone %s display name update: %v", transportZone.Id, err)
+		}
+	}
+
+	return resourceNsxtTransportZoneRead(d, m)
+}
+
+func resourceNsxtTransportZoneRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	transportZone, resp, err := nsxClient.NetworkTransportApi.GetTransportZone(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] TransportZone %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during TransportZone read: %v", err)
+	}
+
+	d.Set("revision", transportZone.Revision)
+	d.Set("description", transportZone.Description)
+	setMPDisplayNameInSchema(d, transportZone.DisplayName)
+	setTagsInSchema(d, transportZone.Tags)
+	d.Set("host_switch_name", transportZone.HostSwitchName)
+	d.Set("nested_nsx", transportZone.NestedNsx)
+	d.Set("transport_type", transportZone.TransportType)
+
+	return nil
+}
+
+func resourceNsxtTransportZoneUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	revision := int64(d.Get("revision").(int))
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	hostSwitchName := d.Get("host_switch_name").(string)
+	nestedNsx := d.Get("nested_nsx").(bool)
+	transportType := d.Get("transport_type").(string)
+	transportZone := manager.TransportZone{
+		Revision:       revision,
+		Description:    description,
+		DisplayName:    displayName,
+		Tags:           tags,
+		HostSwitchName: hostSwitchName,
+		NestedNsx:      nestedNsx,
+		TransportType:  transportType,
+	}
+
+	_, resp, err := nsxClient.NetworkTransportApi.UpdateTransportZone(nsxClient.Context, id, transportZone)
+
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during TransportZone update: %v", err)
+	}
+	getListCache(m).invalidate(transportZoneListCacheKey)
+
+	return resourceNsxtTransportZoneRead(d, m)
+}
+
+func resourceNsxtTransportZoneDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.NetworkTransportApi.DeleteTransportZone(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during TransportZone delete: %v", err)
+	}
+	getListCache(m).invalidate(transportZoneListCacheKey)
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] TransportZone %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
+
+func resourceNsxtTransportZoneListForImport(nsxClient *api.APIClient) ([]importNameCandidate, error) {
+	var candidates []importNameCandidate
+	lister := func(info *paginationInfo) error {
+		objList, _, err := nsxClient.NetworkTransportApi.ListTransportZones(nsxClient.Context, info.LocalVarOptionals)
+		if err != nil {
+			return fmt.Errorf("Error while reading transport zones: %v", err)
+		}
+
+		info.PageCount = int64(len(objList.Results))
+		info.TotalCount = objList.ResultCount
+		info.Cursor = objList.Cursor
+		for _, obj := range objList.Results {
+			candidates = append(candidates, importNameCandidate{ID: obj.Id, DisplayName: obj.DisplayName})
+		}
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	return candidates, err
+}
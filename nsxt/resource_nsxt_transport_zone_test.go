@@ -0,0 +1,289 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+func TestAccResourceNsxtTransportZone_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_transport_zone.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXTransportZoneCheckDestroy(state, updateName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXTransportZoneCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXTransportZoneExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_type", "OVERLAY"),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch_name", "terraform-test-hs"),
+					resource.TestCheckResourceAttr(testResourceName, "nested_nsx", "false"),
+				),
+			},
+			{
+				Config: testAccNSXTransportZoneUpdateTemplate(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXTransportZoneExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_type", "OVERLAY"),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch_name", "terraform-test-hs"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtTransportZone_noName(t *testing.T) {
+	name := ""
+	testResourceName := "nsxt_transport_zone.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXTransportZoneCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXTransportZoneCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXTransportZoneExists(name, testResourceName),
+					resource.TestCheckResourceAttrSet(testResourceName, "display_name"),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_type", "OVERLAY"),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch_name", "terraform-test-hs"),
+					resource.TestCheckResourceAttr(testResourceName, "nested_nsx", "false"),
+				),
+			},
+			{
+				Config: testAccNSXTransportZoneUpdateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXTransportZoneExists(name, testResourceName),
+					resource.TestCheckResourceAttrSet(testResourceName, "display_name"),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_type", "OVERLAY"),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch_name", "terraform-test-hs"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtTransportZone_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_transport_zone.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXTransportZoneCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXTransportZoneCreateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateId:     importByNamePrefix + name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXTransportZoneExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Transport Zone resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Transport Zone resource ID not set in resources ")
+		}
+
+		transportZone, responseCode, err := nsxClient.NetworkTransportApi.GetTransportZone(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Transport Zone ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if Transport Zone %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		// Ignore display name to support the 'no-name' test
+		if displayName == "" || displayName == transportZone.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("Transport Zone %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXTransportZoneCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_transport_zone" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		transportZone, responseCode, err := nsxClient.NetworkTransportApi.GetTransportZone(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving Transport Zone ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == transportZone.DisplayName {
+			return fmt.Errorf("Transport Zone %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXTransportZoneCreateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_transport_zone" "test" {
+  display_name     = "%s"
+  description      = "Acceptance Test"
+  transport_type   = "OVERLAY"
+  host_switch_name = "terraform-test-hs"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func testAccNSXTransportZoneUpdateTemplate(updatedName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_transport_zone" "test" {
+  display_name     = "%s"
+  description      = "Acceptance Test Update"
+  transport_type   = "OVERLAY"
+  host_switch_name = "terraform-test-hs"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  tag {
+    scope = "scope2"
+    tag   = "tag2"
+  }
+}`, updatedName)
+}
+
+func TestResourceNsxtTransportZoneCreate_namePrefix(t *testing.T) {
+	var updated manager.TransportZone
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/transport-zones":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "tz1", "host_switch_name": "hs1", "transport_type": "OVERLAY", "_revision": 0}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/transport-zones/tz1":
+			err := json.NewDecoder(r.Body).Decode(&updated)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprintf(w, `{"id": "tz1", "display_name": "%s", "_revision": 1}`, updated.DisplayName)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/transport-zones/tz1":
+			fmt.Fprintf(w, `{"id": "tz1", "display_name": "%s", "host_switch_name": "hs1", "transport_type": "OVERLAY", "_revision": 1}`, updated.DisplayName)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+	m := nsxtClients{NsxtClient: client, CommonConfig: commonProviderConfig{NamePrefix: "tf-"}}
+
+	d := schema.TestResourceDataRaw(t, resourceNsxtTransportZone().Schema, map[string]interface{}{
+		"host_switch_name": "hs1",
+		"transport_type":   "OVERLAY",
+	})
+	err := resourceNsxtTransportZoneCreate(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.DisplayName != "tf-tz1" {
+		t.Errorf("Expected display name tf-tz1 to be assigned, got %s", updated.DisplayName)
+	}
+	if name := d.Get("display_name").(string); name != "tf-tz1" {
+		t.Errorf("Expected display name tf-tz1 in state, got %s", name)
+	}
+}
+
+func TestResourceNsxtTransportZoneImportByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/transport-zones" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"result_count": 2, "results": [{"id": "tz1", "display_name": "overlay"}, {"id": "tz2", "display_name": "vlan"}]}`)
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+	m := nsxtClients{NsxtClient: client}
+	importer := resourceNsxtTransportZone().Importer.State
+
+	d := schema.TestResourceDataRaw(t, resourceNsxtTransportZone().Schema, map[string]interface{}{})
+	d.SetId(importByNamePrefix + "vlan")
+	result, err := importer(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 1 || result[0].Id() != "tz2" {
+		t.Errorf("Expected transport zone tz2 to be imported, got %v", result)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceNsxtTransportZone().Schema, map[string]interface{}{})
+	d.SetId(importByNamePrefix + "missing")
+	_, err = importer(d, m)
+	if err == nil {
+		t.Errorf("Expected import error for unknown transport zone name")
+	}
+}
@@ -0,0 +1,400 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+var uplinkHostSwitchProfileTeamingPolicyValues = []string{"FAILOVER_ORDER", "LOADBALANCE_SRCID", "LOADBALANCE_SRC_MAC"}
+var uplinkHostSwitchProfileUplinkTypeValues = []string{"PNIC", "LAG"}
+var uplinkHostSwitchProfileLagModeValues = []string{"ACTIVE", "PASSIVE"}
+var uplinkHostSwitchProfileLagTimeoutTypeValues = []string{"SLOW", "FAST"}
+var uplinkHostSwitchProfileLagLoadBalanceAlgorithmValues = []string{
+	"SRCMAC",
+	"DESTMAC",
+	"SRCDESTMAC",
+	"SRCDESTIPVLAN",
+	"SRCDESTMACIPPORT",
+}
+
+const uplinkHostSwitchProfileResourceType = "UplinkHostSwitchProfile"
+
+func resourceNsxtUplinkHostSwitchProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtUplinkHostSwitchProfileCreate,
+		Read:   resourceNsxtUplinkHostSwitchProfileRead,
+		Update: resourceNsxtUplinkHostSwitchProfileUpdate,
+		Delete: resourceNsxtUplinkHostSwitchProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision":     getRevisionSchema(),
+			"description":  getMPDescriptionSchema(),
+			"display_name": getMPDisplayNameSchema(),
+			"tag":          getTagsSchema(),
+			"lag": {
+				Type:        schema.TypeList,
+				Description: "List of link aggregation groups",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "Unique identifier of this link aggregation group",
+							Computed:    true,
+						},
+						"load_balance_algorithm": {
+							Type:         schema.TypeString,
+							Description:  "Load balance algorithm of this link aggregation group",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(uplinkHostSwitchProfileLagLoadBalanceAlgorithmValues, false),
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Description:  "LACP group mode",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(uplinkHostSwitchProfileLagModeValues, false),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of this link aggregation group",
+							Required:    true,
+						},
+						"number_of_uplinks": {
+							Type:         schema.TypeInt,
+							Description:  "Number of uplinks in this link aggregation group",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(2, 32),
+						},
+						"timeout_type": {
+							Type:         schema.TypeString,
+							Description:  "LACP timeout type",
+							Optional:     true,
+							Default:      "SLOW",
+							ValidateFunc: validation.StringInSlice(uplinkHostSwitchProfileLagTimeoutTypeValues, false),
+						},
+						"uplink": {
+							Type:        schema.TypeList,
+							Description: "Uplinks of this link aggregation group, assigned by NSX",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"uplink_name": {
+										Type:        schema.TypeString,
+										Description: "Name of this uplink",
+										Computed:    true,
+									},
+									"uplink_type": {
+										Type:        schema.TypeString,
+										Description: "Type of this uplink",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"mtu": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum transmission unit for the uplinks",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1280),
+			},
+			"teaming": {
+				Type:        schema.TypeList,
+				Description: "Default teaming policy of uplinks",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active": getUplinkHostSwitchProfileUplinkSchema("List of active uplinks", true),
+						"policy": {
+							Type:         schema.TypeString,
+							Description:  "Teaming policy",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(uplinkHostSwitchProfileTeamingPolicyValues, false),
+						},
+						"standby": getUplinkHostSwitchProfileUplinkSchema("List of standby uplinks", false),
+					},
+				},
+			},
+			"transport_vlan": {
+				Type:         schema.TypeInt,
+				Description:  "VLAN used for tagging overlay traffic of associated host switch",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 4094),
+			},
+		},
+	}
+}
+
+func getUplinkHostSwitchProfileUplinkSchema(description string, required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Required:    required,
+		Optional:    !required,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"uplink_name": {
+					Type:        schema.TypeString,
+					Description: "Name of this uplink",
+					Required:    true,
+				},
+				"uplink_type": {
+					Type:         schema.TypeString,
+					Description:  "Type of this uplink",
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(uplinkHostSwitchProfileUplinkTypeValues, false),
+				},
+			},
+		},
+	}
+}
+
+func getUplinksFromSchema(uplinks []interface{}) []manager.Uplink {
+	var uplinkList []manager.Uplink
+	for _, uplink := range uplinks {
+		data := uplink.(map[string]interface{})
+		elem := manager.Uplink{
+			UplinkName: data["uplink_name"].(string),
+			UplinkType: data["uplink_type"].(string),
+		}
+		uplinkList = append(uplinkList, elem)
+	}
+	return uplinkList
+}
+
+func setUplinksInSchema(uplinks []manager.Uplink) []map[string]interface{} {
+	var uplinkList []map[string]interface{}
+	for _, uplink := range uplinks {
+		elem := make(map[string]interface{})
+		elem["uplink_name"] = uplink.UplinkName
+		elem["uplink_type"] = uplink.UplinkType
+		uplinkList = append(uplinkList, elem)
+	}
+	return uplinkList
+}
+
+func getTeamingPolicyFromSchema(d *schema.ResourceData) *manager.TeamingPolicy {
+	teamings := d.Get("teaming").([]interface{})
+	for _, teaming := range teamings {
+		data := teaming.(map[string]interface{})
+		return &manager.TeamingPolicy{
+			ActiveList:  getUplinksFromSchema(data["active"].([]interface{})),
+			Policy:      data["policy"].(string),
+			StandbyList: getUplinksFromSchema(data["standby"].([]interface{})),
+		}
+	}
+	return nil
+}
+
+func setTeamingPolicyInSchema(d *schema.ResourceData, teaming *manager.TeamingPolicy) error {
+	var teamingList []map[string]interface{}
+	if teaming != nil {
+		elem := make(map[string]interface{})
+		elem["active"] = setUplinksInSchema(teaming.ActiveList)
+		elem["policy"] = teaming.Policy
+		elem["standby"] = setUplinksInSchema(teaming.StandbyList)
+		teamingList = append(teamingList, elem)
+	}
+	return d.Set("teaming", teamingList)
+}
+
+func getLagsFromSchema(d *schema.ResourceData) []manager.Lag {
+	lags := d.Get("lag").([]interface{})
+	var lagList []manager.Lag
+	for _, lag := range lags {
+		data := lag.(map[string]interface{})
+		elem := manager.Lag{
+			Id:                   data["id"].(string),
+			LoadBalanceAlgorithm: data["load_balance_algorithm"].(string),
+			Mode:                 data["mode"].(string),
+			Name:                 data["name"].(string),
+			NumberOfUplinks:      int32(data["number_of_uplinks"].(int)),
+			TimeoutType:          data["timeout_type"].(string),
+		}
+		lagList = append(lagList, elem)
+	}
+	return lagList
+}
+
+func setLagsInSchema(d *schema.ResourceData, lags []manager.Lag) error {
+	var lagList []map[string]interface{}
+	for _, lag := range lags {
+		elem := make(map[string]interface{})
+		elem["id"] = lag.Id
+		elem["load_balance_algorithm"] = lag.LoadBalanceAlgorithm
+		elem["mode"] = lag.Mode
+		elem["name"] = lag.Name
+		elem["number_of_uplinks"] = lag.NumberOfUplinks
+		elem["timeout_type"] = lag.TimeoutType
+		elem["uplink"] = setUplinksInSchema(lag.Uplinks)
+		lagList = append(lagList, elem)
+	}
+	return d.Set("lag", lagList)
+}
+
+func resourceNsxtUplinkHostSwitchProfileCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	lags := getLagsFromSchema(d)
+	mtu := int32(d.Get("mtu").(int))
+	teaming := getTeamingPolicyFromSchema(d)
+	transportVlan := int64(d.Get("transport_vlan").(int))
+	profile := manager.UplinkHostSwitchProfile{
+		Description:   description,
+		DisplayName:   displayName,
+		Tags:          tags,
+		ResourceType:  uplinkHostSwitchProfileResourceType,
+		Lags:          lags,
+		Mtu:           mtu,
+		Teaming:       teaming,
+		TransportVlan: transportVlan,
+	}
+
+	err := callMPAPIWithBatch(nsxClient, http.MethodPost, "/v1/host-switch-profiles", profile, &profile)
+	if err != nil {
+		return fmt.Errorf("Error during UplinkHostSwitchProfile create: %v", err)
+	}
+
+	d.SetId(profile.Id)
+
+	if defaultName := getDefaultMPDisplayName(d, m, profile.Id); defaultName != "" {
+		profile.DisplayName = defaultName
+		err = callMPAPIWithBatch(nsxClient, http.MethodPut, fmt.Sprintf("/v1/host-switch-profiles/%s", profile.Id), profile, nil)
+		if err != nil {
+			return fmt.Errorf("Error during UplinkHostSwitchProfile %s display name update: %v", profile.Id, err)
+		}
+	}
+
+	return resourceNsxtUplinkHostSwitchProfileRead(d, m)
+}
+
+func resourceNsxtUplinkHostSwitchProfileRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	var profile manager.UplinkHostSwitchProfile
+	err := callMPAPIWithBatch(nsxClient, http.MethodGet, fmt.Sprintf("/v1/host-switch-profiles/%s", id), nil, &profile)
+	if errors.Is(err, ErrNotFound) {
+		log.Printf("[DEBUG] UplinkHostSwitchProfile %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during UplinkHostSwitchProfile read: %v", err)
+	}
+
+	if profile.ResourceType != uplinkHostSwitchProfileResourceType {
+		return fmt.Errorf("Host switch profile %s is of type %s, expected %s", id, profile.ResourceType, uplinkHostSwitchProfileResourceType)
+	}
+
+	d.Set("revision", profile.Revision)
+	d.Set("description", profile.Description)
+	setMPDisplayNameInSchema(d, profile.DisplayName)
+	setTagsInSchema(d, profile.Tags)
+	d.Set("mtu", profile.Mtu)
+	d.Set("transport_vlan", profile.TransportVlan)
+
+	err = setTeamingPolicyInSchema(d, profile.Teaming)
+	if err != nil {
+		return fmt.Errorf("Error during UplinkHostSwitchProfile teaming set in schema: %v", err)
+	}
+
+	err = setLagsInSchema(d, profile.Lags)
+	if err != nil {
+		return fmt.Errorf("Error during UplinkHostSwitchProfile lags set in schema: %v", err)
+	}
+
+	return nil
+}
+
+func resourceNsxtUplinkHostSwitchProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	revision := int64(d.Get("revision").(int))
+	description := d.Get("description").(string)
+	displayName := d.Get("display_name").(string)
+	tags := getTagsFromSchema(d)
+	lags := getLagsFromSchema(d)
+	mtu := int32(d.Get("mtu").(int))
+	teaming := getTeamingPolicyFromSchema(d)
+	transportVlan := int64(d.Get("transport_vlan").(int))
+	profile := manager.UplinkHostSwitchProfile{
+		Revision:      revision,
+		Description:   description,
+		DisplayName:   displayName,
+		Tags:          tags,
+		ResourceType:  uplinkHostSwitchProfileResourceType,
+		Lags:          lags,
+		Mtu:           mtu,
+		Teaming:       teaming,
+		TransportVlan: transportVlan,
+	}
+
+	err := callMPAPIWithBatch(nsxClient, http.MethodPut, fmt.Sprintf("/v1/host-switch-profiles/%s", id), profile, nil)
+	if err != nil {
+		return fmt.Errorf("Error during UplinkHostSwitchProfile update: %v", err)
+	}
+
+	return resourceNsxtUplinkHostSwitchProfileRead(d, m)
+}
+
+func resourceNsxtUplinkHostSwitchProfileDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.NetworkTransportApi.DeleteHostSwitchProfile(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during UplinkHostSwitchProfile delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] UplinkHostSwitchProfile %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vmware-nsxt/apiservice"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+func TestAccResourceNsxtUplinkHostSwitchProfile_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_uplink_host_switch_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXUplinkHostSwitchProfileCheckDestroy(state, updateName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXUplinkHostSwitchProfileCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXUplinkHostSwitchProfileExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "mtu", "1600"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_vlan", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.policy", "FAILOVER_ORDER"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.active.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.active.0.uplink_name", "uplink1"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.active.0.uplink_type", "PNIC"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.standby.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.standby.0.uplink_name", "uplink2"),
+					resource.TestCheckResourceAttr(testResourceName, "lag.#", "0"),
+				),
+			},
+			{
+				Config: testAccNSXUplinkHostSwitchProfileUpdateTemplate(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXUplinkHostSwitchProfileExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "mtu", "1700"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_vlan", "12"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.policy", "LOADBALANCE_SRCID"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.active.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.active.0.uplink_name", "lag1"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.active.0.uplink_type", "LAG"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.standby.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "lag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "lag.0.name", "lag1"),
+					resource.TestCheckResourceAttr(testResourceName, "lag.0.mode", "ACTIVE"),
+					resource.TestCheckResourceAttr(testResourceName, "lag.0.number_of_uplinks", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "lag.0.timeout_type", "SLOW"),
+					resource.TestCheckResourceAttr(testResourceName, "lag.0.uplink.#", "2"),
+					resource.TestCheckResourceAttrSet(testResourceName, "lag.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtUplinkHostSwitchProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_uplink_host_switch_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXUplinkHostSwitchProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXUplinkHostSwitchProfileUpdateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXUplinkHostSwitchProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Uplink Host Switch Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Uplink Host Switch Profile resource ID not set in resources ")
+		}
+
+		profile, responseCode, err := nsxClient.NetworkTransportApi.GetHostSwitchProfile(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Uplink Host Switch Profile ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if Uplink Host Switch Profile %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == profile.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("Uplink Host Switch Profile %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXUplinkHostSwitchProfileCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_uplink_host_switch_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		profile, responseCode, err := nsxClient.NetworkTransportApi.GetHostSwitchProfile(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving Uplink Host Switch Profile ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == profile.DisplayName {
+			return fmt.Errorf("Uplink Host Switch Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXUplinkHostSwitchProfileCreateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_uplink_host_switch_profile" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  mtu          = 1600
+
+  teaming {
+    policy = "FAILOVER_ORDER"
+
+    active {
+      uplink_name = "uplink1"
+      uplink_type = "PNIC"
+    }
+
+    standby {
+      uplink_name = "uplink2"
+      uplink_type = "PNIC"
+    }
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func testAccNSXUplinkHostSwitchProfileUpdateTemplate(updatedName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_uplink_host_switch_profile" "test" {
+  display_name   = "%s"
+  description    = "Acceptance Test Update"
+  mtu            = 1700
+  transport_vlan = 12
+
+  lag {
+    name                   = "lag1"
+    load_balance_algorithm = "SRCDESTIPVLAN"
+    mode                   = "ACTIVE"
+    number_of_uplinks      = 2
+  }
+
+  teaming {
+    policy = "LOADBALANCE_SRCID"
+
+    active {
+      uplink_name = "lag1"
+      uplink_type = "LAG"
+    }
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  tag {
+    scope = "scope2"
+    tag   = "tag2"
+  }
+}`, updatedName)
+}
+
+// Uplink profile attributes are missing from base host switch profile type
+// in MP SDK, and are expected to survive the round trip via batch API
+func TestCallMPAPIWithBatch_uplinkHostSwitchProfile(t *testing.T) {
+	var received []apiservice.BatchRequestItem
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/batch" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var batch apiservice.BatchRequest
+		err := json.NewDecoder(r.Body).Decode(&batch)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received = append(received, batch.Requests...)
+
+		var item string
+		switch batch.Requests[0].Uri {
+		case "/v1/host-switch-profiles/profile1":
+			item = `{"code": 200, "body": {"id": "profile1", "resource_type": "UplinkHostSwitchProfile", "mtu": 1600, "teaming": {"policy": "FAILOVER_ORDER", "active_list": [{"uplink_name": "uplink1", "uplink_type": "PNIC"}]}, "lags": [{"id": "lag-id", "name": "lag1", "mode": "ACTIVE", "number_of_uplinks": 2}]}}`
+		default:
+			item = `{"code": 404, "body": {"error_code": 202, "error_message": "The requested object could not be found"}}`
+		}
+		fmt.Fprintf(w, `{"has_errors": false, "results": [%s]}`, item)
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+
+	var profile manager.UplinkHostSwitchProfile
+	err := callMPAPIWithBatch(client, http.MethodGet, "/v1/host-switch-profiles/profile1", nil, &profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received[0].Method != http.MethodGet || received[0].Body != nil {
+		t.Errorf("Expected GET request without body, got %s %v", received[0].Method, received[0].Body)
+	}
+	if profile.Mtu != 1600 || profile.Teaming == nil || len(profile.Teaming.ActiveList) != 1 || profile.Teaming.ActiveList[0].UplinkName != "uplink1" {
+		t.Errorf("Unexpected profile teaming or mtu: %+v", profile)
+	}
+	if len(profile.Lags) != 1 || profile.Lags[0].Id != "lag-id" || profile.Lags[0].NumberOfUplinks != 2 {
+		t.Errorf("Unexpected profile lags: %+v", profile.Lags)
+	}
+
+	err = callMPAPIWithBatch(client, http.MethodGet, "/v1/host-switch-profiles/profile2", nil, &profile)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
@@ -75,7 +75,7 @@ func resourceNsxtVlanLogicalSwitchCreate(d *schema.ResourceData, m interface{})
 	displayName := d.Get("display_name").(string)
 	tags := getTagsFromSchema(d)
 	addressBindings := getAddressBindingsFromSchema(d)
-	adminState := d.Get("admin_state").(string)
+	adminState := getAdminStateFromSchema(d)
 	ipPoolID := d.Get("ip_pool_id").(string)
 	macPoolID := d.Get("mac_pool_id").(string)
 	switchingProfileID := getSwitchingProfileIdsFromSchema(d)
@@ -101,7 +101,7 @@ func resourceNsxtVlanLogicalSwitchCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Error during LogicalSwitch create: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusCreated {
+	if !isSuccess(resp.StatusCode) {
 		return fmt.Errorf("Unexpected status returned during LogicalSwitch create: %v", resp.StatusCode)
 	}
 
@@ -174,7 +174,7 @@ func resourceNsxtVlanLogicalSwitchUpdate(d *schema.ResourceData, m interface{})
 	displayName := d.Get("display_name").(string)
 	tags := getTagsFromSchema(d)
 	addressBindings := getAddressBindingsFromSchema(d)
-	adminState := d.Get("admin_state").(string)
+	adminState := getAdminStateFromSchema(d)
 	ipPoolID := d.Get("ip_pool_id").(string)
 	macPoolID := d.Get("mac_pool_id").(string)
 	switchingProfileID := getSwitchingProfileIdsFromSchema(d)
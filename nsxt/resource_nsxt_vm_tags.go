@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/apiservice"
 	"github.com/vmware/go-vmware-nsxt/common"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
@@ -160,6 +161,36 @@ func findPortsByExternalID(nsxClient *api.APIClient, instanceID string) ([]manag
 	return resultList, nil
 }
 
+func getMPTagScopesFromSet(tags *schema.Set) map[string]bool {
+	scopes := make(map[string]bool)
+	for _, tag := range tags.List() {
+		data := tag.(map[string]interface{})
+		scopes[data["scope"].(string)] = true
+	}
+	return scopes
+}
+
+// Scopes of tags managed by this resource, either configured now or before
+// the change. Tags with other scopes were set outside terraform and are kept.
+func getMPManagedTagScopes(d *schema.ResourceData, schemaName string) map[string]bool {
+	oldTags, newTags := d.GetChange(schemaName)
+	scopes := getMPTagScopesFromSet(oldTags.(*schema.Set))
+	for scope := range getMPTagScopesFromSet(newTags.(*schema.Set)) {
+		scopes[scope] = true
+	}
+	return scopes
+}
+
+func filterMPTagsByScope(tags []common.Tag, scopes map[string]bool, managed bool) []common.Tag {
+	result := make([]common.Tag, 0)
+	for _, tag := range tags {
+		if scopes[tag.Scope] == managed {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
 func updateTags(nsxClient *api.APIClient, id string, tags []common.Tag) error {
 	log.Printf("[DEBUG] Updating tags for %s", id)
 
@@ -181,7 +212,11 @@ func updateTags(nsxClient *api.APIClient, id string, tags []common.Tag) error {
 	return nil
 }
 
-func updatePortTags(nsxClient *api.APIClient, id string, tags []common.Tag) error {
+// Maximum number of logical port updates sent in single batch request
+const vmTagsPortBatchSize = 100
+
+// Replace tags with managed scopes on all logical ports of the VM
+func updatePortTags(nsxClient *api.APIClient, id string, tags []common.Tag, scopes map[string]bool) error {
 	log.Printf("[DEBUG] Updating logical port tags for %s", id)
 
 	ports, err := findPortsByExternalID(nsxClient, id)
@@ -189,24 +224,73 @@ func updatePortTags(nsxClient *api.APIClient, id string, tags []common.Tag) erro
 		return err
 	}
 
-	portsUpdated := 0
-
-	for _, port := range ports {
-		port.Tags = tags
-		log.Printf("[DEBUG] Applying %d tags on logical port %s", len(tags), port.Id)
+	if len(ports) == 1 {
+		port := ports[0]
+		port.Tags = append(filterMPTagsByScope(port.Tags, scopes, false), tags...)
+		log.Printf("[DEBUG] Applying %d tags on logical port %s", len(port.Tags), port.Id)
 		_, resp, err := nsxClient.LogicalSwitchingApi.UpdateLogicalPort(nsxClient.Context, port.Id, port)
 
 		if err != nil || (resp != nil && resp.StatusCode == http.StatusNotFound) {
 			return fmt.Errorf("Error while updating tags on logical port %s: %v", port.Id, err)
 		}
-		portsUpdated++
+	} else {
+		// VM with multiple vNICs has a port per vNIC, hence port updates are
+		// grouped in batch requests rather than sent one by one
+		for start := 0; start < len(ports); start += vmTagsPortBatchSize {
+			end := start + vmTagsPortBatchSize
+			if end > len(ports) {
+				end = len(ports)
+			}
+			err = updatePortTagsInBatch(nsxClient, ports[start:end], tags, scopes)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
-	log.Printf("[INFO] Applied %d tags on %d logical ports for VM %s", len(tags), portsUpdated, id)
+	log.Printf("[INFO] Applied %d tags on %d logical ports for VM %s", len(tags), len(ports), id)
 
 	return nil
 }
 
+func updatePortTagsInBatch(nsxClient *api.APIClient, ports []manager.LogicalPort, tags []common.Tag, scopes map[string]bool) error {
+	var requests []apiservice.BatchRequestItem
+	for _, port := range ports {
+		port.Tags = append(filterMPTagsByScope(port.Tags, scopes, false), tags...)
+		log.Printf("[DEBUG] Applying %d tags on logical port %s", len(port.Tags), port.Id)
+		var body interface{} = port
+		requests = append(requests, apiservice.BatchRequestItem{
+			Body:   &body,
+			Method: http.MethodPut,
+			Uri:    fmt.Sprintf("/v1/logical-ports/%s", port.Id),
+		})
+	}
+
+	batch := apiservice.BatchRequest{
+		Requests: requests,
+	}
+	localVarOptionals := make(map[string]interface{})
+	result, resp, err := nsxClient.ApiServicesApi.RegisterBatchRequest(nsxClient.Context, batch, localVarOptionals)
+	if err != nil {
+		return fmt.Errorf("Error while updating tags on logical ports: %v", err)
+	}
+	if !isSuccess(resp.StatusCode) {
+		return fmt.Errorf("Unexpected status returned while updating tags on logical ports: %v", resp.StatusCode)
+	}
+
+	if !result.HasErrors {
+		return nil
+	}
+
+	var failedPorts []string
+	for i, item := range result.Results {
+		if i < len(ports) && !isSuccess(int(item.Code)) {
+			failedPorts = append(failedPorts, fmt.Sprintf("%s (status %d)", ports[i].Id, item.Code))
+		}
+	}
+	return fmt.Errorf("Error while updating tags on logical ports: %s", strings.Join(failedPorts, ", "))
+}
+
 func resourceNsxtVMTagsCreate(d *schema.ResourceData, m interface{}) error {
 	instanceID := d.Get("instance_id").(string)
 
@@ -220,17 +304,20 @@ func resourceNsxtVMTagsCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during VM retrieval: %v", err)
 	}
 
-	tags := getTagsFromSchema(d)
-	if len(tags) > 0 || d.HasChange("tag") {
+	// Preserve tags with scopes that were never configured in this resource
+	scopes := getMPManagedTagScopes(d, "tag")
+	if len(scopes) > 0 {
+		tags := append(filterMPTagsByScope(vm.Tags, scopes, false), getTagsFromSchema(d)...)
 		err = updateTags(nsxClient, vm.ExternalId, tags)
 		if err != nil {
 			return err
 		}
 	}
 
-	portTags := getCustomizedTagsFromSchema(d, "logical_port_tag")
-	if len(portTags) > 0 || d.HasChange("logical_port_tag") {
-		err = updatePortTags(nsxClient, vm.ExternalId, portTags)
+	portScopes := getMPManagedTagScopes(d, "logical_port_tag")
+	if len(portScopes) > 0 {
+		portTags := getCustomizedTagsFromSchema(d, "logical_port_tag")
+		err = updatePortTags(nsxClient, vm.ExternalId, portTags, portScopes)
 		if err != nil {
 			return err
 		}
@@ -262,11 +349,21 @@ func resourceNsxtVMTagsRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during logical port retrieval: %v", err)
 	}
 
-	setTagsInSchema(d, vm.Tags)
+	// for import, all tags are considered managed
+	isImport := d.Get("instance_id").(string) == ""
+	if isImport {
+		setTagsInSchema(d, vm.Tags)
+	} else {
+		setTagsInSchema(d, filterMPTagsByScope(vm.Tags, getMPManagedTagScopes(d, "tag"), true))
+	}
 	// assuming all ports have same tags
 	// note - more flexible implementation will be provided with policy resource
 	if len(ports) > 0 {
-		setCustomizedTagsInSchema(d, ports[0].Tags, "logical_port_tag")
+		portTags := ports[0].Tags
+		if !isImport {
+			portTags = filterMPTagsByScope(portTags, getMPManagedTagScopes(d, "logical_port_tag"), true)
+		}
+		setCustomizedTagsInSchema(d, portTags, "logical_port_tag")
 	} else {
 		// assign empty list
 		var tagList []map[string]string
@@ -293,20 +390,18 @@ func resourceNsxtVMTagsDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error during VM retrieval: %v", err)
 	}
 
-	noTags := make([]common.Tag, 0)
-	vmTags := getTagsFromSchema(d)
-	if len(vmTags) > 0 {
-		// Update tags only if they were configured by the provider
-		err = updateTags(nsxClient, vm.ExternalId, noTags)
+	// Remove only tags managed by this resource
+	scopes := getMPManagedTagScopes(d, "tag")
+	if len(scopes) > 0 {
+		err = updateTags(nsxClient, vm.ExternalId, filterMPTagsByScope(vm.Tags, scopes, false))
 		if err != nil {
 			return err
 		}
 	}
 
-	portTags := getCustomizedTagsFromSchema(d, "logical_port_tag")
-	if len(portTags) > 0 {
-		// Update port tags only if they were configured by the provider
-		err := updatePortTags(nsxClient, vm.ExternalId, noTags)
+	portScopes := getMPManagedTagScopes(d, "logical_port_tag")
+	if len(portScopes) > 0 {
+		err := updatePortTags(nsxClient, vm.ExternalId, make([]common.Tag, 0), portScopes)
 		if err != nil {
 			return err
 		}
@@ -4,11 +4,17 @@
 package nsxt
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vmware-nsxt/apiservice"
+	"github.com/vmware/go-vmware-nsxt/common"
 )
 
 var vmTagsResourceName = "test"
@@ -81,6 +87,108 @@ func TestAccResourceNsxtVMTags_import_basic(t *testing.T) {
 	})
 }
 
+var testAccVMTagsExternalScope = "external"
+
+func TestAccResourceNsxtVMTags_externalTags(t *testing.T) {
+	vmID := getTestVMID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccTestMP(t)
+			testAccEnvDefined(t, "NSXT_TEST_VM_ID")
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXVMTagsCheckExternalTagsDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXVMTagsCreateTemplate(vmID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXVMTagsCheckExists(),
+					resource.TestCheckResourceAttr(vmTagsFullResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccNSXVMTagsAddExternalTag(t)
+				},
+				Config: testAccNSXVMTagsUpdateTemplate(vmID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXVMTagsCheckExists(),
+					resource.TestCheckResourceAttr(vmTagsFullResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(vmTagsFullResourceName, "logical_port_tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXVMTagsAddExternalTag(t *testing.T) {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	vm, err := findVMIDByLocalID(nsxClient, getTestVMID())
+	if err != nil {
+		t.Fatalf("Failed to find VM: %v", err)
+	}
+
+	tag := common.Tag{Scope: testAccVMTagsExternalScope, Tag: "tag1"}
+	err = updateTags(nsxClient, vm.ExternalId, append(vm.Tags, tag))
+	if err != nil {
+		t.Fatalf("Failed to add external tag to VM %s: %v", vm.ExternalId, err)
+	}
+
+	ports, err := findPortsByExternalID(nsxClient, vm.ExternalId)
+	if err != nil || len(ports) == 0 {
+		t.Fatalf("Failed to find logical ports for VM %s: %v", vm.ExternalId, err)
+	}
+	err = updatePortTags(nsxClient, vm.ExternalId, []common.Tag{tag}, map[string]bool{testAccVMTagsExternalScope: true})
+	if err != nil {
+		t.Fatalf("Failed to add external tag to logical ports of VM %s: %v", vm.ExternalId, err)
+	}
+}
+
+func testAccNSXVMTagsCheckExternalTagsDestroy(state *terraform.State) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_vm_tags" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		vm, err := findVMByExternalID(nsxClient, resourceID)
+		if err != nil {
+			return fmt.Errorf("Failed to find VM %s", resourceID)
+		}
+		if len(vm.Tags) != 1 || vm.Tags[0].Scope != testAccVMTagsExternalScope {
+			return fmt.Errorf("VM %s is expected to keep only external tag, got %v", resourceID, vm.Tags)
+		}
+
+		ports, err := findPortsByExternalID(nsxClient, resourceID)
+		if err != nil {
+			return fmt.Errorf("Failed to find logical ports for VM %s", resourceID)
+		}
+		for _, port := range ports {
+			if len(port.Tags) != 1 || port.Tags[0].Scope != testAccVMTagsExternalScope {
+				return fmt.Errorf("Logical port %s is expected to keep only external tag, got %v", port.Id, port.Tags)
+			}
+		}
+
+		// Cleanup external tags
+		noTags := make([]common.Tag, 0)
+		err = updateTags(nsxClient, resourceID, noTags)
+		if err != nil {
+			return err
+		}
+		err = updatePortTags(nsxClient, resourceID, noTags, map[string]bool{testAccVMTagsExternalScope: true})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func testAccNSXVMTagsCheckExists() resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 
@@ -164,3 +272,135 @@ resource "nsxt_vm_tags" "%s" {
   }
 }`, vmTagsResourceName, instanceID)
 }
+
+// Tagging a VM with N vNICs used to take N logical port updates, while
+// now port updates are sent in single batch request
+func TestUpdatePortTags_batch(t *testing.T) {
+	portCount := 3
+	portUpdates := 0
+	batchRequests := 0
+	batchItems := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/fabric/vifs":
+			vifs := fmt.Sprintf(`{"result_count": %d, "results": [`, portCount)
+			for i := 0; i < portCount; i++ {
+				if i > 0 {
+					vifs += ","
+				}
+				vifs += fmt.Sprintf(`{"owner_vm_id": "vm1", "lport_attachment_id": "att%d"}`, i)
+			}
+			fmt.Fprint(w, vifs+"]}")
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/logical-ports":
+			ports := fmt.Sprintf(`{"result_count": %d, "results": [`, portCount)
+			for i := 0; i < portCount; i++ {
+				if i > 0 {
+					ports += ","
+				}
+				ports += fmt.Sprintf(`{"id": "port%d", "logical_switch_id": "ls1", "admin_state": "UP", "attachment": {"id": "att%d"}}`, i, i)
+			}
+			fmt.Fprint(w, ports+"]}")
+		case r.Method == http.MethodPut:
+			portUpdates++
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, "{}")
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/batch":
+			batchRequests++
+			var batch apiservice.BatchRequest
+			err := json.NewDecoder(r.Body).Decode(&batch)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			batchItems += len(batch.Requests)
+			result := apiservice.BatchResponse{}
+			for range batch.Requests {
+				result.Results = append(result.Results, apiservice.BatchResponseItem{Code: http.StatusOK})
+			}
+			json.NewEncoder(w).Encode(result)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+
+	tags := []common.Tag{{Scope: "scope1", Tag: "tag1"}}
+	err := updatePortTags(client, "vm1", tags, map[string]bool{"scope1": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if portUpdates != 0 || batchRequests != 1 || batchItems != portCount {
+		t.Errorf("expected %d ports updated with single batch request, got %d port updates and %d batch requests with %d items", portCount, portUpdates, batchRequests, batchItems)
+	}
+}
+
+func TestResourceNsxtVMTagsDelete_externalTags(t *testing.T) {
+	var vmTags []common.Tag
+	var portTags []common.Tag
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/session/create":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/fabric/virtual-machines":
+			fmt.Fprint(w, `{"result_count": 1, "results": [{"external_id": "vm1", "compute_ids": ["biosUuid:bios1"],
+			  "tags": [{"scope": "scope1", "tag": "tag1"}, {"scope": "external", "tag": "tag2"}]}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/fabric/vifs":
+			fmt.Fprint(w, `{"result_count": 1, "results": [{"owner_vm_id": "vm1", "lport_attachment_id": "att1"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/logical-ports":
+			fmt.Fprint(w, `{"result_count": 1, "results": [{"id": "port1", "logical_switch_id": "ls1", "admin_state": "UP", "attachment": {"id": "att1"},
+			  "tags": [{"scope": "a", "tag": "b"}, {"scope": "external", "tag": "tag3"}]}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/fabric/virtual-machines":
+			var update struct {
+				Tags []common.Tag `json:"tags"`
+			}
+			err := json.NewDecoder(r.Body).Decode(&update)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			vmTags = update.Tags
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/logical-ports/port1":
+			var port struct {
+				Tags []common.Tag `json:"tags"`
+			}
+			err := json.NewDecoder(r.Body).Decode(&port)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			portTags = port.Tags
+			fmt.Fprint(w, `{"id": "port1"}`)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestMPClient(t, server)
+	m := nsxtClients{NsxtClient: client}
+
+	d := schema.TestResourceDataRaw(t, resourceNsxtVMTags().Schema, map[string]interface{}{
+		"instance_id":      "bios1",
+		"tag":              []interface{}{map[string]interface{}{"scope": "scope1", "tag": "tag1"}},
+		"logical_port_tag": []interface{}{map[string]interface{}{"scope": "a", "tag": "b"}},
+	})
+	d.SetId("vm1")
+	err := resourceNsxtVMTagsDelete(d, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(vmTags) != 1 || vmTags[0].Scope != "external" {
+		t.Errorf("Expected only external VM tag to survive destroy, got %v", vmTags)
+	}
+	if len(portTags) != 1 || portTags[0].Scope != "external" {
+		t.Errorf("Expected only external port tag to survive destroy, got %v", portTags)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -1456,10 +1455,10 @@ func nsxtPolicySegmentDelete(d *schema.ResourceData, m interface{}, isFixed bool
 			return ports, "ok", nil
 
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 1 * time.Second,
-		Delay:      1 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Delay:   getPollInterval(m),
 	}
+	setPollBackoff(m, stateConf)
 	if !isFixed {
 		_, err := stateConf.WaitForState()
 		if err != nil {
@@ -0,0 +1,93 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+// Sweepers are invoked with go test -sweep=<any>, and remove objects left on
+// NSX by interrupted acceptance tests. Use only against test environments.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("nsxt_static_route", &resource.Sweeper{
+		Name: "nsxt_static_route",
+		F:    testSweepNsxtStaticRoutes,
+	})
+}
+
+// Objects created by acceptance tests are named with the test prefix, or
+// tagged with it as scope
+func isAccTestObject(displayName string, tags []common.Tag) bool {
+	if strings.HasPrefix(displayName, defaultTestResourceName) {
+		return true
+	}
+
+	for _, tag := range tags {
+		if tag.Scope == defaultTestResourceName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func testSweepNsxtStaticRoutes(region string) error {
+	nsxClient, err := testAccGetClient()
+	if err != nil {
+		return fmt.Errorf("Error during test client initialization: %v", err)
+	}
+
+	var routers []manager.LogicalRouter
+	lister := func(info *paginationInfo) error {
+		objList, _, err := nsxClient.LogicalRoutingAndServicesApi.ListLogicalRouters(nsxClient.Context, info.LocalVarOptionals)
+		if err != nil {
+			return fmt.Errorf("Error while reading logical routers: %v", err)
+		}
+
+		info.PageCount = int64(len(objList.Results))
+		info.TotalCount = objList.ResultCount
+		info.Cursor = objList.Cursor
+		routers = append(routers, objList.Results...)
+		return nil
+	}
+
+	_, err = handlePagination(lister)
+	if err != nil {
+		return err
+	}
+
+	for _, router := range routers {
+		routes, err := listLogicalRouterStaticRoutes(nsxClient, router.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, route := range routes {
+			if !isAccTestObject(route.DisplayName, route.Tags) {
+				continue
+			}
+
+			log.Printf("[INFO] Sweeping static route %s (%s) on router %s", route.DisplayName, route.Id, router.Id)
+			resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteStaticRoute(nsxClient.Context, router.Id, route.Id)
+			err = wrapMPAPIError(resp, err)
+			if err != nil && !errors.Is(err, ErrNotFound) {
+				return fmt.Errorf("Error during static route %s deletion: %v", route.Id, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -5,15 +5,24 @@ package nsxt
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"hash/crc32"
 	"log"
 	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/apiservice"
 	"github.com/vmware/go-vmware-nsxt/common"
 	"github.com/vmware/go-vmware-nsxt/manager"
 
@@ -88,6 +97,44 @@ func getRevisionSchema() *schema.Schema {
 	}
 }
 
+// utilities to define & handle display name and description of MP resources.
+// NSX Manager assigns object ID as display name if it is not specified,
+// hence display_name is optional and computed.
+func getMPDisplayNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The display name of this resource. Defaults to ID if not set",
+		Optional:    true,
+		Computed:    true,
+	}
+}
+
+func getMPDescriptionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Description of this resource",
+		Optional:    true,
+	}
+}
+
+func setMPDisplayNameInSchema(d *schema.ResourceData, displayName string) {
+	if displayName == "" {
+		displayName = d.Id()
+	}
+	d.Set("display_name", displayName)
+}
+
+// Display name to assign to MP object created without display_name, composed
+// of provider name_prefix and object ID. Empty if display_name is configured
+// or no prefix is set, in which case NSX defaults display name to ID.
+func getDefaultMPDisplayName(d *schema.ResourceData, m interface{}, id string) string {
+	prefix := getCommonProviderConfig(m).NamePrefix
+	if prefix == "" || d.Get("display_name").(string) != "" {
+		return ""
+	}
+	return prefix + id
+}
+
 // utilities to define & handle tags
 func getTagsSchemaInternal(required bool, forceNew bool) *schema.Schema {
 	return &schema.Schema{
@@ -445,10 +492,15 @@ func getAdminStateSchema() *schema.Schema {
 		Optional:     true,
 		Description:  "Represents Desired state of the object",
 		Default:      "UP",
-		ValidateFunc: validation.StringInSlice(adminStateValues, false),
+		ValidateFunc: validateEnum(adminStateValues),
+		StateFunc:    toUpperStateFunc,
 	}
 }
 
+func getAdminStateFromSchema(d *schema.ResourceData) string {
+	return strings.ToUpper(d.Get("admin_state").(string))
+}
+
 func getIDSetSchema(description string) *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeSet,
@@ -583,11 +635,11 @@ func nsxVersionHigherOrEqual(ver string) bool {
 }
 
 func resourceNotSupportedError() error {
-	return fmt.Errorf("This resource is not supported with given provider settings")
+	return fmt.Errorf("This resource is %w", ErrNotSupported)
 }
 
 func dataSourceNotSupportedError() error {
-	return fmt.Errorf("This data source is not supported with given provider settings")
+	return fmt.Errorf("This data source is %w", ErrNotSupported)
 }
 
 func stringInList(target string, list []string) bool {
@@ -646,3 +698,228 @@ func handlePagination(lister func(*paginationInfo) error) (int64, error) {
 
 	return total, nil
 }
+
+const importByNamePrefix = "name:"
+
+type importNameCandidate struct {
+	ID          string
+	DisplayName string
+}
+
+// Importer that accepts either object ID, or display name in name:<display_name> form.
+// The lister is expected to return all objects of the relevant type.
+func nsxtResourceImporterByName(objType string, lister func(nsxClient *api.APIClient) ([]importNameCandidate, error)) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		importID := d.Id()
+		if !strings.HasPrefix(importID, importByNamePrefix) {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		displayName := strings.TrimPrefix(importID, importByNamePrefix)
+		if displayName == "" {
+			return nil, fmt.Errorf("Empty display name specified for %s import", objType)
+		}
+
+		nsxClient := m.(nsxtClients).NsxtClient
+		if nsxClient == nil {
+			return nil, resourceNotSupportedError()
+		}
+
+		candidates, err := lister(nsxClient)
+		if err != nil {
+			return nil, err
+		}
+
+		var matches []string
+		for _, candidate := range candidates {
+			if candidate.DisplayName == displayName {
+				matches = append(matches, candidate.ID)
+			}
+		}
+
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("%s with name '%s' was not found", objType, displayName)
+		}
+		if len(matches) > 1 {
+			return nil, fmt.Errorf("Found multiple %s objects with name '%s': %s. Please import by ID", objType, displayName, strings.Join(matches, ", "))
+		}
+
+		d.SetId(matches[0])
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func listLogicalRoutersForImport(nsxClient *api.APIClient, routerType string) ([]importNameCandidate, error) {
+	var candidates []importNameCandidate
+	lister := func(info *paginationInfo) error {
+		info.LocalVarOptionals["routerType"] = routerType
+		objList, _, err := nsxClient.LogicalRoutingAndServicesApi.ListLogicalRouters(nsxClient.Context, info.LocalVarOptionals)
+		if err != nil {
+			return fmt.Errorf("Error while reading logical routers: %v", err)
+		}
+
+		info.PageCount = int64(len(objList.Results))
+		info.TotalCount = objList.ResultCount
+		info.Cursor = objList.Cursor
+		for _, obj := range objList.Results {
+			candidates = append(candidates, importNameCandidate{ID: obj.Id, DisplayName: obj.DisplayName})
+		}
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	return candidates, err
+}
+
+// MP SDK models some polymorphic objects with base type only, which lacks
+// attributes of the specific type (such as uplink host switch profile, or
+// deployment info of edge transport node). In order to send and receive the
+// complete object, the call is submitted via batch API, and body and result
+// are marshalled from and into given types. Either of them can be nil.
+func callMPAPIWithBatch(nsxClient *api.APIClient, method string, uri string, body interface{}, result interface{}) error {
+	request := apiservice.BatchRequestItem{
+		Method: method,
+		Uri:    uri,
+	}
+	if body != nil {
+		request.Body = &body
+	}
+
+	batch := apiservice.BatchRequest{
+		Requests: []apiservice.BatchRequestItem{request},
+	}
+	localVarOptionals := make(map[string]interface{})
+	batchResult, resp, err := nsxClient.ApiServicesApi.RegisterBatchRequest(nsxClient.Context, batch, localVarOptionals)
+	if err != nil {
+		return wrapMPAPIError(resp, err)
+	}
+	if !isSuccess(resp.StatusCode) {
+		return fmt.Errorf("Unexpected status returned: %v", resp.StatusCode)
+	}
+	if len(batchResult.Results) != 1 {
+		return fmt.Errorf("Unexpected number of results returned: %d", len(batchResult.Results))
+	}
+
+	item := batchResult.Results[0]
+	var itemBody []byte
+	if item.Body != nil {
+		itemBody, err = json.Marshal(*item.Body)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !isSuccess(int(item.Code)) {
+		// Present the error the same way MP SDK does, so that error details
+		// are parsed and matched by wrapMPAPIError
+		err = fmt.Errorf("Status: %d, Body: %s", item.Code, itemBody)
+		return wrapMPAPIError(&http.Response{StatusCode: int(item.Code)}, err)
+	}
+
+	if result != nil && len(itemBody) > 0 {
+		if logging.IsDebugOrHigher() {
+			if unmapped := getUnmappedAPIFields(itemBody, result); len(unmapped) > 0 {
+				log.Printf("[DEBUG] Fields returned by %s %s are not modeled by the provider: %s", method, uri, strings.Join(unmapped, ", "))
+			}
+		}
+		return json.Unmarshal(itemBody, result)
+	}
+	return nil
+}
+
+// Collect json field names of given struct type, including fields of
+// embedded structs
+func getJSONFieldNames(t reflect.Type, names map[string]bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if field.Anonymous && name == "" {
+			getJSONFieldNames(field.Type, names)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = true
+	}
+}
+
+// Return sorted top level fields of API response body that are dropped
+// when the body is unmarshalled into result
+func getUnmappedAPIFields(body []byte, result interface{}) []string {
+	var fields map[string]interface{}
+	if json.Unmarshal(body, &fields) != nil {
+		return nil
+	}
+
+	known := make(map[string]bool)
+	getJSONFieldNames(reflect.TypeOf(result), known)
+	var unmapped []string
+	for name := range fields {
+		if !known[name] {
+			unmapped = append(unmapped, name)
+		}
+	}
+	sort.Strings(unmapped)
+	return unmapped
+}
+
+// Default interval between reads while waiting for object realization or deletion
+const defaultPollInterval = 2 * time.Second
+
+// Poll interval configured in the provider, shared by realization and delete waits
+func getPollInterval(m interface{}) time.Duration {
+	interval := getCommonProviderConfig(m).PollInterval
+	if interval <= 0 {
+		return defaultPollInterval
+	}
+	return interval
+}
+
+// Upper bound for backoff between reads, same as terraform SDK default
+const maxPollBackoff = 10 * time.Second
+
+// Shared polling backoff for realization and deletion waits. Reads start at
+// provider poll interval and back off exponentially up to maxPollBackoff.
+// Intervals configured above the bound are used as fixed interval.
+func setPollBackoff(m interface{}, stateConf *resource.StateChangeConf) {
+	interval := getPollInterval(m)
+	if interval >= maxPollBackoff {
+		stateConf.PollInterval = interval
+		return
+	}
+	stateConf.MinTimeout = interval
+}
+
+// Some objects are still returned for a while after their deletion is
+// accepted, and re-creating an object with same name fails meanwhile.
+// Wait until read fails with not found error, or timeout expires.
+func waitForDeletion(m interface{}, timeout time.Duration, readFunc func() error) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			err := readFunc()
+			if errors.Is(err, ErrNotFound) {
+				return "", "deleted", nil
+			}
+			if err != nil {
+				return nil, "", err
+			}
+
+			log.Printf("[DEBUG] Object still exists, waiting for deletion")
+			return "", "deleting", nil
+		},
+		Timeout: timeout,
+	}
+	setPollBackoff(m, stateConf)
+	_, err := stateConf.WaitForState()
+	return err
+}
@@ -4,16 +4,21 @@
 package nsxt
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/manager"
 	"github.com/vmware/go-vmware-nsxt/trust"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -141,6 +146,26 @@ func getTestCertificateName(isClient bool) string {
 	return os.Getenv("NSXT_TEST_CERTIFICATE_NAME")
 }
 
+func getTestComputeManagerName() string {
+	return os.Getenv("NSXT_TEST_COMPUTE_MANAGER")
+}
+
+func getTestComputeCollectionName() string {
+	return os.Getenv("NSXT_TEST_COMPUTE_COLLECTION")
+}
+
+func getTestFabricNodeID() string {
+	return os.Getenv("NSXT_TEST_FABRIC_NODE_ID")
+}
+
+func getTestEdgeStorageID() string {
+	return os.Getenv("NSXT_TEST_EDGE_STORAGE_ID")
+}
+
+func getTestEdgeNetworkID() string {
+	return os.Getenv("NSXT_TEST_EDGE_NETWORK_ID")
+}
+
 func getTestLBServiceName() string {
 	return os.Getenv("NSXT_TEST_LB_SERVICE_NAME")
 }
@@ -492,3 +517,169 @@ func testAccNsxtPolicyResourceCheckDestroy(state *terraform.State, displayName s
 	}
 	return nil
 }
+
+func TestSetMPDisplayNameInSchema(t *testing.T) {
+	staticRouteSchema := resourceNsxtStaticRoute().Schema
+	if !staticRouteSchema["display_name"].Optional || !staticRouteSchema["display_name"].Computed {
+		t.Fatalf("Expected display_name to be optional and computed")
+	}
+
+	d := schema.TestResourceDataRaw(t, staticRouteSchema, map[string]interface{}{})
+	d.SetId("route-1")
+
+	setMPDisplayNameInSchema(d, "")
+	if value := d.Get("display_name").(string); value != "route-1" {
+		t.Errorf("Expected display_name to default to ID, got %s", value)
+	}
+
+	setMPDisplayNameInSchema(d, "test-route")
+	if value := d.Get("display_name").(string); value != "test-route" {
+		t.Errorf("Expected display_name test-route, got %s", value)
+	}
+}
+
+func TestGetUnmappedAPIFields(t *testing.T) {
+	body := []byte(`{"id": "profile1", "_revision": 2, "mtu": 1600, "teaming": {"policy": "FAILOVER_ORDER"}, "overlay_encap": "GENEVE", "named_teamings": []}`)
+	unmapped := getUnmappedAPIFields(body, &manager.UplinkHostSwitchProfile{})
+	if strings.Join(unmapped, ",") != "named_teamings,overlay_encap" {
+		t.Errorf("Unexpected unmapped fields: %v", unmapped)
+	}
+
+	// fields of embedded structs are considered mapped
+	type extendedProfile struct {
+		manager.UplinkHostSwitchProfile
+		OverlayEncap string `json:"overlay_encap,omitempty"`
+	}
+	unmapped = getUnmappedAPIFields(body, &extendedProfile{})
+	if strings.Join(unmapped, ",") != "named_teamings" {
+		t.Errorf("Unexpected unmapped fields: %v", unmapped)
+	}
+
+	if unmapped = getUnmappedAPIFields([]byte(`[]`), &extendedProfile{}); len(unmapped) != 0 {
+		t.Errorf("Expected no unmapped fields for non-object body, got: %v", unmapped)
+	}
+}
+
+func TestWaitForDeletion(t *testing.T) {
+	m := nsxtClients{CommonConfig: commonProviderConfig{PollInterval: time.Millisecond}}
+
+	attempts := 0
+	err := waitForDeletion(m, time.Minute, func() error {
+		attempts++
+		if attempts < 3 {
+			return nil
+		}
+		return fmt.Errorf("%w: 404 Not Found", ErrNotFound)
+	})
+	if err != nil || attempts != 3 {
+		t.Errorf("expected deletion after 3 attempts, got %d attempts and error: %v", attempts, err)
+	}
+
+	attempts = 0
+	err = waitForDeletion(m, time.Minute, func() error {
+		attempts++
+		return ErrConflict
+	})
+	if !errors.Is(err, ErrConflict) || attempts != 1 {
+		t.Errorf("expected conflict error without retries, got %d attempts and error: %v", attempts, err)
+	}
+
+	err = waitForDeletion(m, 50*time.Millisecond, func() error {
+		return nil
+	})
+	if err == nil {
+		t.Errorf("expected timeout error for object that is never deleted")
+	}
+}
+
+func TestWaitForDeletion_pollInterval(t *testing.T) {
+	interval := 50 * time.Millisecond
+	m := nsxtClients{CommonConfig: commonProviderConfig{PollInterval: interval}}
+
+	var reads []time.Time
+	err := waitForDeletion(m, time.Minute, func() error {
+		reads = append(reads, time.Now())
+		if len(reads) < 3 {
+			return nil
+		}
+		return fmt.Errorf("%w: 404 Not Found", ErrNotFound)
+	})
+	if err != nil || len(reads) != 3 {
+		t.Fatalf("expected deletion after 3 reads, got %d reads and error: %v", len(reads), err)
+	}
+	for i := 1; i < len(reads); i++ {
+		if gap := reads[i].Sub(reads[i-1]); gap < interval {
+			t.Errorf("expected reads at least %v apart, got %v", interval, gap)
+		}
+	}
+	// Reads back off exponentially rather than polling at fixed interval
+	if first, second := reads[1].Sub(reads[0]), reads[2].Sub(reads[1]); second <= first {
+		t.Errorf("expected growing interval between reads, got %v and %v", first, second)
+	}
+}
+
+func TestGetPollInterval(t *testing.T) {
+	if interval := getPollInterval(nsxtClients{}); interval != defaultPollInterval {
+		t.Errorf("expected default poll interval %v, got %v", defaultPollInterval, interval)
+	}
+
+	m := nsxtClients{CommonConfig: commonProviderConfig{PollInterval: 10 * time.Second}}
+	if interval := getPollInterval(m); interval != 10*time.Second {
+		t.Errorf("expected configured poll interval, got %v", interval)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceNsxtPolicyIPAddressAllocation().Schema, map[string]interface{}{})
+	stateConf := nsxtPolicyWaitForRealizationStateConf(nil, d, m, "/infra/realized-state")
+	if stateConf.PollInterval != 10*time.Second || stateConf.Delay != 10*time.Second {
+		t.Errorf("expected realization wait to use configured poll interval, got %v", stateConf.PollInterval)
+	}
+
+	// Intervals below the backoff bound are used as minimal interval
+	m = nsxtClients{CommonConfig: commonProviderConfig{PollInterval: time.Second}}
+	stateConf = nsxtPolicyWaitForRealizationStateConf(nil, d, m, "/infra/realized-state")
+	if stateConf.PollInterval != 0 || stateConf.MinTimeout != time.Second || stateConf.Delay != time.Second {
+		t.Errorf("expected realization wait to back off from configured poll interval, got min %v, fixed %v", stateConf.MinTimeout, stateConf.PollInterval)
+	}
+
+	config := initCommonConfig(schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{}))
+	if config.PollInterval != defaultPollInterval {
+		t.Errorf("expected provider default poll interval %v, got %v", defaultPollInterval, config.PollInterval)
+	}
+}
+
+func newTestFabricNodeServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/v1/fabric/nodes" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// Two pages, with duplicate name across pages
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprint(w, `{"result_count": 3, "cursor": "page2", "results": [
+			  {"id": "node1", "display_name": "edge1", "resource_type": "EdgeNode", "ip_addresses": ["10.0.0.1"]},
+			  {"id": "node2", "display_name": "host1", "resource_type": "HostNode", "ip_addresses": ["10.0.0.2"]}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"result_count": 3, "results": [
+		  {"id": "node3", "display_name": "host1", "resource_type": "HostNode", "ip_addresses": ["10.0.0.3"]}]}`)
+	}))
+}
+
+// Manager API client that sends requests to given test server
+func newTestMPClient(t *testing.T, server *httptest.Server) *api.APIClient {
+	cfg := api.Configuration{
+		BasePath:   "/api/v1",
+		Host:       server.Listener.Addr().String(),
+		Scheme:     "http",
+		UserAgent:  "terraform-provider-nsxt/1.0",
+		HTTPClient: server.Client(),
+	}
+	client, err := api.NewAPIClient(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
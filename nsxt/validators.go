@@ -71,7 +71,8 @@ func isIPRange(v string) bool {
 	if ip1 == nil || ip2 == nil {
 		return false
 	}
-	return true
+	// Both ends of the range must belong to same address family
+	return (ip1.To4() == nil) == (ip2.To4() == nil)
 }
 
 func isSingleIP(v string) bool {
@@ -80,17 +81,20 @@ func isSingleIP(v string) bool {
 }
 
 func isCidr(v string, allowMaxPrefix bool, isIP bool) bool {
-	_, ipnet, err := net.ParseCIDR(v)
+	ip, ipnet, err := net.ParseCIDR(v)
 	if err != nil {
 		return false
 	}
 	if ipnet == nil {
 		return false
 	}
-	if isIP && (v == ipnet.String()) && !allowMaxPrefix {
+	// Compare addresses rather than strings, since same IPv6 address can
+	// be spelled in multiple ways (leading zeros, upper case, :: notation)
+	isNetwork := ip.Equal(ipnet.IP)
+	if isIP && isNetwork && !allowMaxPrefix {
 		return false
 	}
-	if !isIP && (v != ipnet.String()) {
+	if !isIP && !isNetwork {
 		return false
 	}
 
@@ -102,6 +106,49 @@ func isCidr(v string, allowMaxPrefix bool, isIP bool) bool {
 	return true
 }
 
+// Convert CIDR to its canonical network form, i.e. clear host bits and
+// translate dotted netmask notation (10.0.0.0/255.255.255.0) to prefix length
+func normalizeCidr(v string) (*net.IPNet, error) {
+	s := strings.Split(v, "/")
+	if len(s) == 2 {
+		mask := net.ParseIP(s[1])
+		if mask != nil && mask.To4() != nil {
+			ones, bits := net.IPMask(mask.To4()).Size()
+			if bits == 0 {
+				return nil, fmt.Errorf("invalid netmask in %s", v)
+			}
+			v = fmt.Sprintf("%s/%d", s[0], ones)
+		}
+	}
+
+	_, ipnet, err := net.ParseCIDR(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return ipnet, nil
+}
+
+func normalizeCidrStateFunc(i interface{}) string {
+	v := i.(string)
+	ipnet, err := normalizeCidr(v)
+	if err != nil {
+		return v
+	}
+
+	return ipnet.String()
+}
+
+func normalizeIPStateFunc(i interface{}) string {
+	v := i.(string)
+	ip := net.ParseIP(v)
+	if ip == nil {
+		return v
+	}
+
+	return ip.String()
+}
+
 func validatePortAddress() schema.SchemaValidateFunc {
 	// Expects ip_address/prefix (prefix < 32)
 	return func(i interface{}, k string) (s []string, es []error) {
@@ -167,6 +214,35 @@ func validateSingleIP() schema.SchemaValidateFunc {
 	}
 }
 
+// Validate value against allowed enum values, ignoring case. Should be
+// combined with toUpperStateFunc, so that value is sent to NSX as expected.
+func validateEnum(allowed []string) schema.SchemaValidateFunc {
+	return validation.StringInSlice(allowed, true)
+}
+
+// Store enum values in upper case, which NSX expects
+func toUpperStateFunc(v interface{}) string {
+	return strings.ToUpper(v.(string))
+}
+
+// MAC address in EUI-48 format, such as 00:50:56:01:02:03
+func validateMAC() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		mac, err := net.ParseMAC(v)
+		if err != nil || len(mac) != 6 {
+			es = append(es, fmt.Errorf(
+				"expected %s to contain a valid MAC address, got: %s", k, v))
+		}
+		return
+	}
+}
+
 func isValidStringUint(value string, bits int) bool {
 	_, err := strconv.ParseUint(value, 10, bits)
 	return (err == nil)
@@ -265,6 +341,42 @@ func validateCidr() schema.SchemaValidateFunc {
 	}
 }
 
+// Accept any CIDR representation that normalizes to IPv4 or IPv6 network
+func validateCidrNetwork() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		_, err := normalizeCidr(v)
+		if err != nil {
+			es = append(es, fmt.Errorf(
+				"expected %s to contain a valid CIDR, got: %s", k, v))
+		}
+		return
+	}
+}
+
+// Accept single IPv4 or IPv6 address, or CIDR in either network or
+// address/prefix form
+func validateIPOrCidr() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if !isSingleIP(v) && !isCidr(v, true, true) {
+			es = append(es, fmt.Errorf(
+				"expected %s to contain a valid IP or CIDR, got: %s", k, v))
+		}
+		return
+	}
+}
+
 func validateIPCidr() schema.SchemaValidateFunc {
 	return func(i interface{}, k string) (s []string, es []error) {
 		v, ok := i.(string)
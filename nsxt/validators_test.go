@@ -0,0 +1,113 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testValidator(t *testing.T, validator schema.SchemaValidateFunc, valid []string, invalid []string) {
+	for _, v := range valid {
+		_, errs := validator(v, "test")
+		if len(errs) > 0 {
+			t.Errorf("Expected %s to be valid, got %v", v, errs)
+		}
+	}
+	for _, v := range invalid {
+		_, errs := validator(v, "test")
+		if len(errs) == 0 {
+			t.Errorf("Expected %s to be invalid", v)
+		}
+	}
+}
+
+func TestValidateCidr(t *testing.T) {
+	valid := []string{"10.0.0.0/24", "0.0.0.0/0", "2001:db8::/32", "2001:DB8::/32", "2001:0db8:0000::/48", "::/0"}
+	invalid := []string{"10.0.0.1/24", "10.0.0.0", "2001:db8::1/32", "2001:db8::", "abc"}
+	testValidator(t, validateCidr(), valid, invalid)
+}
+
+func TestValidateIPCidr(t *testing.T) {
+	valid := []string{"10.0.0.1/24", "10.0.0.0/24", "2001:db8::1/64", "2001:DB8::1/64", "fe80::1/10"}
+	invalid := []string{"10.0.0.1", "2001:db8::1", "2001:db8::1/129"}
+	testValidator(t, validateIPCidr(), valid, invalid)
+}
+
+func TestValidateIPOrCidr(t *testing.T) {
+	valid := []string{"10.0.0.1", "10.0.0.0/24", "10.0.0.1/24", "2001:db8::1", "2001:db8::/32", "2001:DB8::1/64"}
+	invalid := []string{"10.0.0.1-10.0.0.5", "2001:db8::/129", "10.0.0.256", "abc"}
+	testValidator(t, validateIPOrCidr(), valid, invalid)
+}
+
+func TestValidateCidrOrIPOrRange(t *testing.T) {
+	valid := []string{"10.0.0.1", "10.0.0.0/24", "10.0.0.1-10.0.0.5", "2001:db8::1", "2001:0DB8::/32", "2001:db8::1-2001:db8::ff"}
+	invalid := []string{"10.0.0.1-2001:db8::1", "2001:db8::1/32", "10.0.0.1/24"}
+	testValidator(t, validateCidrOrIPOrRange(), valid, invalid)
+}
+
+func TestValidateCidrNetwork(t *testing.T) {
+	valid := []string{"10.0.0.0/24", "10.0.0.1/24", "10.0.0.0/255.255.255.0", "2001:db8::/32", "2001:db8::1/64"}
+	invalid := []string{"10.0.0.0", "2001:db8::", "10.0.0.0/255.0.255.0", "2001:db8::/129"}
+	testValidator(t, validateCidrNetwork(), valid, invalid)
+}
+
+func TestNormalizeCidrStateFunc(t *testing.T) {
+	cases := map[string]string{
+		"10.0.0.1/24":            "10.0.0.0/24",
+		"10.0.0.0/255.255.255.0": "10.0.0.0/24",
+		"2001:DB8::1/32":         "2001:db8::/32",
+		"2001:0db8:0000::/48":    "2001:db8::/48",
+		"2001:db8:0:0:1::/64":    "2001:db8::/64",
+		"invalid":                "invalid",
+	}
+	for input, expected := range cases {
+		if value := normalizeCidrStateFunc(input); value != expected {
+			t.Errorf("Expected %s to normalize to %s, got %s", input, expected, value)
+		}
+	}
+}
+
+func TestNormalizeIPStateFunc(t *testing.T) {
+	cases := map[string]string{
+		"10.0.0.1":                "10.0.0.1",
+		"2001:DB8:0:0:0:0:0:1":    "2001:db8::1",
+		"2001:0db8:0000:0000::ff": "2001:db8::ff",
+		"invalid":                 "invalid",
+	}
+	for input, expected := range cases {
+		if value := normalizeIPStateFunc(input); value != expected {
+			t.Errorf("Expected %s to normalize to %s, got %s", input, expected, value)
+		}
+	}
+}
+
+func TestValidateMAC(t *testing.T) {
+	valid := []string{"00:50:56:01:02:03", "00-50-56-AB-CD-EF", "0050.5601.0203"}
+	invalid := []string{"00:50:56:01:02", "00:00:00:00:fe:80:00:00", "00:50:56:01:02:0g", "10.0.0.1", ""}
+	testValidator(t, validateMAC(), valid, invalid)
+}
+
+func TestValidateEnum(t *testing.T) {
+	valid := []string{"UP", "DOWN", "up", "Down"}
+	invalid := []string{"", "UNKNOWN", "u p"}
+	testValidator(t, validateEnum(adminStateValues), valid, invalid)
+}
+
+func TestAdminStateCaseInsensitive(t *testing.T) {
+	for _, value := range []string{"down", "Down", "DOWN"} {
+		if toUpperStateFunc(value) != "DOWN" {
+			t.Errorf("Expected %s to be normalized to DOWN, got %s", value, toUpperStateFunc(value))
+		}
+
+		d := schema.TestResourceDataRaw(t, resourceNsxtLogicalSwitch().Schema, map[string]interface{}{
+			"admin_state":       value,
+			"transport_zone_id": "tz1",
+		})
+		if adminState := getAdminStateFromSchema(d); adminState != "DOWN" {
+			t.Errorf("Expected admin_state %s to be sent as DOWN, got %s", value, adminState)
+		}
+	}
+}